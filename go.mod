@@ -1,3 +1,3 @@
 module teladoc
 
-go 1.18
+go 1.24
@@ -0,0 +1,60 @@
+package bignumber
+
+// Accumulator keeps a running total of BigInts, it reuses an internal
+// buffer so adding to the total doesn't allocate once the buffer is
+// large enough. The zero value is an empty total ready to use.
+type Accumulator struct {
+	// total holds the sum of the non-negative values padded with leading
+	// zero chunks, so the carries can grow into them without reallocating
+	total []uint64
+	// negativeTotal holds the sum of the absolute values of the negative
+	// values, padded like total
+	negativeTotal []uint64
+}
+
+// Add adds the BigInt to the total, a nil BigInt is treated as zero.
+func (a *Accumulator) Add(value *BigInt) {
+	value = value.inChunksOf(maxChunkSize)
+
+	// INFO: the negative values are summed apart, so adding to the
+	// total is always an addition and the carries stay in place
+	total := &a.total
+	if value.negative {
+		total = &a.negativeTotal
+	}
+
+	magnitude := normalizeMagnitude(value.magnitude, value.base())
+
+	// INFO: keeping an extra chunk over the operand means the carry never
+	// overflows the buffer, the leading chunk of the total is always zero
+	if len(*total) <= len(magnitude) || (*total)[0] != 0 {
+		*total = growTotal(*total, len(magnitude)+1)
+	}
+
+	addMagnitudeInPlace(*total, magnitude, powersOfTen[maxChunkSize])
+}
+
+// Sum returns a snapshot of the total, the accumulator can keep being used.
+func (a *Accumulator) Sum() *BigInt {
+	if len(a.total) == 0 && len(a.negativeTotal) == 0 {
+		return NewZero()
+	}
+
+	positive := signedMagnitude{trimLeadingZeroChunks(a.total), false}
+	negative := signedMagnitude{trimLeadingZeroChunks(a.negativeTotal), true}
+
+	// INFO: the signed addition builds a new magnitude, so the
+	// snapshot doesn't share the buffers of the accumulator
+	return newBigIntFromSigned(addSigned(positive, negative, powersOfTen[maxChunkSize]), maxChunkSize)
+}
+
+// growTotal returns the total with room for at least size chunks, the buffer
+// is doubled so the growth is amortized over the additions.
+func growTotal(total []uint64, size int) []uint64 {
+	size = max(size, 2*len(total))
+
+	grown := make([]uint64, size)
+	copy(grown[size-len(total):], total)
+
+	return grown
+}
@@ -0,0 +1,145 @@
+package bignumber
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestAccumulator(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"0"},
+		{"123", "456"},
+		{"999999999", "1"},
+		{"999999999999999999", "999999999999999999", "999999999999999999"},
+		{"1", "340282366920938463463374607431768211455", "0", "7"},
+		{"000000000000000000123", "21127612734691273469127461293748612340"},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			var (
+				accumulator Accumulator
+				want        = new(big.Int)
+			)
+
+			for _, value := range tc {
+				accumulator.Add(MustNewBigInt(value))
+
+				number, _ := new(big.Int).SetString(value, 10)
+				want.Add(want, number)
+
+				// Every snapshot must match the running total
+				if got := accumulator.Sum(); got.String() != want.String() {
+					t.Errorf("got %v, want %v", got.String(), want.String())
+				}
+			}
+
+			if got := accumulator.Sum(); got.String() != want.String() {
+				t.Errorf("got %v, want %v", got.String(), want.String())
+			}
+		})
+	}
+}
+
+func TestAccumulatorSigned(t *testing.T) {
+	tests := [][]string{
+		{"-5"},
+		{"5", "-8"},
+		{"-8", "5", "3"},
+		{"-999999999999999999", "-1", "1000000000000000001"},
+		{"123456789012345678901234567890", "-123456789012345678901234567891"},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			var (
+				accumulator Accumulator
+				want        = new(big.Int)
+			)
+
+			for _, value := range tc {
+				accumulator.Add(MustNewBigInt(value))
+
+				number, _ := new(big.Int).SetString(value, 10)
+				want.Add(want, number)
+
+				if got := accumulator.Sum(); got.String() != want.String() {
+					t.Errorf("got %v, want %v", got.String(), want.String())
+				}
+			}
+		})
+	}
+}
+
+func TestAccumulatorSumIsASnapshot(t *testing.T) {
+	var accumulator Accumulator
+
+	accumulator.Add(MustNewBigInt("100"))
+	snapshot := accumulator.Sum()
+	accumulator.Add(MustNewBigInt("23"))
+
+	if got := snapshot.String(); got != "100" {
+		t.Errorf("got %v, want %v", got, "100")
+	}
+
+	if got := accumulator.Sum().String(); got != "123" {
+		t.Errorf("got %v, want %v", got, "123")
+	}
+}
+
+func TestAccumulatorAddNil(t *testing.T) {
+	var accumulator Accumulator
+
+	accumulator.Add(nil)
+
+	if got := accumulator.Sum().String(); got != "0" {
+		t.Errorf("got %v, want %v", got, "0")
+	}
+}
+
+func TestAccumulatorDoesNotAllocate(t *testing.T) {
+	var accumulator Accumulator
+
+	value := MustNewBigInt(strings.Repeat("9", 100))
+	accumulator.Add(value)
+
+	allocs := testing.AllocsPerRun(1000, func() {
+		accumulator.Add(value)
+	})
+
+	if allocs != 0 {
+		t.Errorf("got %v allocations, want 0", allocs)
+	}
+}
+
+func BenchmarkAccumulatorAdd(b *testing.B) {
+	var accumulator Accumulator
+
+	value := MustNewBigInt(strings.Repeat("1234567890", 10))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		accumulator.Add(value)
+	}
+}
+
+func BenchmarkBigIntAddInPlace(b *testing.B) {
+	total := NewZero()
+	value := MustNewBigInt(strings.Repeat("1234567890", 10))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = total.AddInPlace(value)
+	}
+}
@@ -31,6 +31,12 @@ func NewBigFloat(value string) (*BigFloat, error) {
 		return nil, ErrInvalidDecimalNumber
 	}
 
+	// INFO: NewBigInt accepts a zero decimal part, so a second decimal
+	// point must be rejected here to keep 1.2.0 from being parsed
+	if strings.Contains(decimal, ".") {
+		return nil, ErrConvertingChunkToInteger
+	}
+
 	// INFO: this should be calculated before removing the leading zeros
 	precision := len(decimal)
 
@@ -113,12 +119,7 @@ func (b BigFloat) Add(other *BigFloat) (*BigFloat, error) {
 	// Check if decimal should carry over to integer
 	if decimal.Length() > lhsDecimal.Length() {
 		// Add carry to integer
-		oneBigInt, err := NewBigInt("1")
-		if err != nil {
-			return nil, err
-		}
-
-		integer = integer.Add(oneBigInt)
+		integer = integer.Add(NewOne())
 
 		// Remove the carry value form the decimal and
 		// update the float values
@@ -37,6 +37,11 @@ func TestNewBigFloat(t *testing.T) {
 			want:  "",
 			err:   ErrConvertingChunkToInteger,
 		},
+		{
+			input: "1.2.0",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
 		{
 			input: "123",
 			want:  "",
@@ -1,151 +1,730 @@
 package bignumber
 
-import (
-	"math"
-	"strconv"
-	"strings"
+import "strconv"
 
-	"teladoc/internal/utils"
-)
+// maxChunkSize is the maximum number of digits a chunk can hold
+// without overflowing the sum of two chunks in a uint64.
+const maxChunkSize = 18
+
+// asciiSpace holds the whitespace characters trimmed from the parsed values.
+const asciiSpace = " \t\n\v\f\r"
+
+// powersOfTen holds the exact powers of ten up to maxChunkSize, it is used
+// instead of `math.Pow10` to avoid the float64 to uint64 conversion.
+var powersOfTen = [maxChunkSize + 1]uint64{
+	1,
+	10,
+	100,
+	1000,
+	10000,
+	100000,
+	1000000,
+	10000000,
+	100000000,
+	1000000000,
+	10000000000,
+	100000000000,
+	1000000000000,
+	10000000000000,
+	100000000000000,
+	1000000000000000,
+	10000000000000000,
+	100000000000000000,
+	1000000000000000000,
+}
 
 // BigInt is a integer number with arbitrary precision.
+// The zero value is 0 and ready to use, just like `big.Int`.
+// The number is stored as a sign and the magnitude of its absolute value.
+//
+// INFO: A nil *BigInt is treated as zero, both as a receiver and as an
+// operand. The methods that modify the receiver in place panic on nil.
+//
+// The operations that return a *BigInt, like Add or Mul, always return a new
+// value that shares no chunks with the receiver or the operands, so the
+// intermediate values of a chain like a.Add(b).Add(c) can be kept and later
+// modified in place, Ex: with AddInPlace, without changing each other.
+// Clamp is the exception, it returns one of its values as it is.
 type BigInt struct {
 	// magnitude is where the number is stored in chunks
-	magnitude []uint32
+	magnitude []uint64
 	// length represents the number of digits in the BigInt
 	length int
 	// chukSize represents the number of digits in each chunk
 	chukSize int
+	// frozen marks the shared values that must not be modified
+	frozen bool
+	// negative marks the values lower than zero, zero is never negative
+	negative bool
 }
 
 // NewBigInt creates a new BigInt from a string
-// The string must be a valid integer number
-// and must not contain any decimal places
+// The string must be a valid integer number, with an optional '-' sign,
+// and must not contain any decimal places, except for
+// a redundant decimal part where every digit is zero.
+// The surrounding whitespace and quotes are ignored
 //
-// Ex: 123, 123456789012345678901234567890, etc.
-func NewBigInt(value string) (*BigInt, error) {
-	// Break the string into chunks of 8 digits
-	// Breaking in chunks of 8 digits allows us to use uint32
-	// to store and perform the addition operation on the number
-	// TODO: Invsigate if we can use any other data type
-	chunkSize := 9
-	chunks := utils.ChunkStringFromRight(value, chunkSize)
-
-	magnitude := make([]uint32, len(chunks))
-
-	// Convert each chunk to uint32
-	for idx, chunk := range chunks {
-		integer, err := utils.StringToUint32(chunk)
-		if err != nil {
-			return nil, ErrConvertingChunkToInteger
-		}
-
-		magnitude[idx] = integer
+// Ex: 123, -123, 123.000, " 123\n", "\"123\"", 123456789012345678901234567890, etc.
+//
+// The options change how the value is parsed and stored, see WithChunkSize,
+// WithSeparators and AllowSign. Without options the rules above apply as they are.
+func NewBigInt(value string, opts ...Option) (*BigInt, error) {
+	if len(opts) > 0 {
+		return newBigIntWithOptions(value, opts)
 	}
 
-	bigInt := &BigInt{
-		magnitude: magnitude,
-		length:    len(value),
-		chukSize:  chunkSize,
+	bigInt := &BigInt{}
+
+	if err := bigInt.SetString(value); err != nil {
+		return nil, err
 	}
 
 	return bigInt, nil
 }
 
-// Length returns the number of digits in the BigInt.
-func (b BigInt) Length() int {
-	return b.length
+// SetString sets the BigInt to the value of the string, following the same
+// rules as NewBigInt. The receiver chunks are reused when they have enough
+// capacity, and the receiver is left unchanged when the value is invalid.
+func (b *BigInt) SetString(value string) error {
+	if b == nil {
+		panic("bignumber: cannot set the value of a nil *BigInt")
+	}
+
+	if b.frozen {
+		return ErrFrozen
+	}
+
+	magnitude, negative, err := parseDecimal(b.magnitude, value, maxChunkSize)
+	if err != nil {
+		return err
+	}
+
+	b.magnitude = magnitude
+	b.chukSize = maxChunkSize
+	b.negative = negative && !isZeroMagnitude(magnitude)
+
+	// INFO: the leading zeros are not part of the length, Ex: 007 has 1 digit
+	b.length = b.digits()
+
+	return nil
 }
 
-// String returns the string representation of the BigInt.
-func (b BigInt) String() string {
-	var result strings.Builder
+// MustNewBigInt is like NewBigInt but panics if the value cannot be parsed.
+// It is intended for constants and test fixtures, not for user input.
+func MustNewBigInt(value string, opts ...Option) *BigInt {
+	bigInt, err := NewBigInt(value, opts...)
+	if err != nil {
+		panic("bignumber: NewBigInt(" + strconv.Quote(value) + "): " + err.Error())
+	}
 
-	for _, chunk := range b.magnitude {
-		value := strconv.FormatUint(uint64(chunk), 10)
-		result.WriteString(value)
+	return bigInt
+}
+
+// Zero and One are shared BigInt values for the common constants.
+//
+// INFO: These values are frozen, the methods that modify the receiver in
+// place return ErrFrozen instead. Use NewZero or NewOne to get a fresh
+// copy that can be safely mutated. The package never reads these variables
+// internally, so reassigning them doesn't change the package behavior.
+var (
+	// Zero is the BigInt representation of 0.
+	Zero = freeze(NewZero())
+	// One is the BigInt representation of 1.
+	One = freeze(NewOne())
+)
+
+// zero is the internal BigInt used in place of nil operands.
+var zero = freeze(NewZero())
+
+// orZero returns the receiver, or the internal zero when the receiver is nil.
+func (b *BigInt) orZero() *BigInt {
+	if b == nil {
+		return zero
 	}
 
-	return result.String()
+	return b
 }
 
-// Add adds two BigInts and returns the result.
-func (b BigInt) Add(other *BigInt) *BigInt {
-	lhs, rhs := b.magnitude, other.magnitude
+// inChunksOf returns the BigInt stored in chunks of chunkSize digits, or the
+// BigInt itself when it already is. The operands of an operation are stored
+// in the chunks of the receiver so they share the same base. A nil BigInt is zero.
+func (b *BigInt) inChunksOf(chunkSize int) *BigInt {
+	b = b.orZero()
+
+	if b.chunkSize() == chunkSize {
+		return b
+	}
+
+	// INFO: the digits don't depend on the chunk size,
+	// so they are regrouped through the decimal representation
+	normalized := &BigInt{magnitude: normalizeMagnitude(b.magnitude, b.base()), chukSize: b.chukSize}
+	magnitude, _, _ := parseDecimal(nil, normalized.appendDecimal(nil), chunkSize)
+
+	converted := newBigIntFromMagnitude(magnitude, chunkSize)
+	converted.negative = b.negative
+
+	return converted
+}
+
+// signed returns the normalized magnitude of the BigInt along with its sign.
+func (b *BigInt) signed() signedMagnitude {
+	return signedMagnitude{normalizeMagnitude(b.magnitude, b.base()), b.negative}
+}
+
+// newBigIntFromSigned creates a new BigInt from a signed magnitude,
+// the sign of a zero magnitude is dropped.
+func newBigIntFromSigned(value signedMagnitude, chunkSize int) *BigInt {
+	bigInt := newBigIntFromMagnitude(value.magnitude, chunkSize)
+	bigInt.negative = value.negative && !isZeroMagnitude(bigInt.magnitude)
+
+	return bigInt
+}
+
+// chunkSize returns the number of digits in each chunk, the zero value
+// BigInt has no chunk size so it defaults to maxChunkSize.
+func (b *BigInt) chunkSize() int {
+	if b.chukSize == 0 {
+		return maxChunkSize
+	}
+
+	return b.chukSize
+}
+
+// base returns the base of the chunks, every chunk must be lower than it.
+func (b *BigInt) base() uint64 {
+	return powersOfTen[b.chunkSize()]
+}
+
+// freeze marks the BigInt as frozen and returns it.
+func freeze(b *BigInt) *BigInt {
+	b.frozen = true
+
+	return b
+}
+
+// set replaces the value of the receiver, it returns ErrFrozen
+// if the receiver is one of the shared frozen values.
+func (b *BigInt) set(other *BigInt) error {
+	if b == nil {
+		panic("bignumber: cannot set the value of a nil *BigInt")
+	}
+
+	if b.frozen {
+		return ErrFrozen
+	}
+
+	*b = *other
+
+	return nil
+}
 
-	// Make sure the larger magnitude is always on the left
-	if b.Length() < other.Length() {
-		lhs, rhs = rhs, lhs
+// NewZero creates a new BigInt with the value 0.
+func NewZero() *BigInt {
+	return &BigInt{
+		magnitude: []uint64{0},
+		length:    1,
+		chukSize:  maxChunkSize,
+	}
+}
+
+// NewOne creates a new BigInt with the value 1.
+func NewOne() *BigInt {
+	return &BigInt{
+		magnitude: []uint64{1},
+		length:    1,
+		chukSize:  maxChunkSize,
+	}
+}
+
+// Length returns the number of digits in the BigInt, the sign is not a digit.
+func (b *BigInt) Length() int {
+	b = b.orZero()
+
+	// INFO: the zero value has no length, so it is computed from the chunks
+	if b.length == 0 {
+		return b.digits()
 	}
 
-	// Create a new BigInt to hold the result
-	result := &BigInt{
-		magnitude: make([]uint32, len(lhs)),
+	return b.length
+}
+
+// Normalize converts the BigInt to its canonical form in place, the chunks
+// that overflow the chunk size are carried, the leading zero chunks are
+// removed and the length is recomputed, Ex: [0, 0, 5] becomes [5].
+//
+// INFO: the frozen values are always canonical, so they are left as they are.
+func (b *BigInt) Normalize() {
+	if b == nil {
+		panic("bignumber: cannot normalize a nil *BigInt")
 	}
 
-	// Siplify the addition for single chuck setup
-	if len(lhs) == 1 {
-		result.magnitude[0] = lhs[0] + rhs[0]
+	if b.frozen {
+		return
+	}
+
+	b.magnitude = normalizeMagnitude(b.magnitude, b.base())
+	b.length = b.digits()
+}
+
+// NumChunks returns the number of chunks used to store the BigInt.
+//
+// INFO: every chunk holds up to chukSize digits, so for a normalized value
+// NumChunks is ceil(Length / chukSize). A bigger count means there are
+// leading zero chunks left, and the zero value has no chunks at all.
+func (b *BigInt) NumChunks() int {
+	b = b.orZero()
+
+	return len(b.magnitude)
+}
+
+// Magnitude returns a copy of the chunks used to store the BigInt, from the
+// most significant to the least significant one. Every chunk is a digit in base
+// 10^chukSize, Ex: 1234567890123456789 is [1, 234567890123456789] with the
+// default chunk size of 18.
+func (b *BigInt) Magnitude() []uint64 {
+	b = b.orZero()
+
+	// INFO: the chunks are copied so the caller can't modify the BigInt
+	return append([]uint64{}, b.magnitude...)
+}
 
-		// TODO: Investigate if we can compute the length while computing the sum
-		result.length = len(result.String())
+// Log10 returns the floor of the logarithm in base 10 of the BigInt,
+// Ex: 999 returns 2 and 1000 returns 3.
+//
+// The logarithm of 0 is undefined, so it returns ErrLogOfZero.
+func (b *BigInt) Log10() (int, error) {
+	b = b.orZero()
 
-		return result
+	if isZeroMagnitude(b.magnitude) {
+		return 0, ErrLogOfZero
 	}
 
-	var carry bool
+	return b.Length() - 1, nil
+}
 
-	for offset := 1; offset <= len(lhs); offset++ {
-		// Get the chunk lhsIndex
-		lhsIndex := len(lhs) - offset
-		rhsIndex := len(rhs) - offset
+// String returns the string representation of the BigInt.
+func (b *BigInt) String() string {
+	b = b.orZero()
 
-		// Get the chunk values, rhs may be shorter than lhs
-		// so we need to check if the index is out of bounds
-		// and if so, default to `0` as the value
-		var (
-			lhsChunk = lhs[lhsIndex]
-			rhsChunk uint32
-		)
+	// INFO: every chunk holds at most chunkSize digits, so this is
+	// enough to build the whole string without reallocations
+	return string(b.appendDecimal(make([]byte, 0, len(b.magnitude)*b.chunkSize()+1)))
+}
+
+// ToDecimalBytes returns the decimal representation of the BigInt as ASCII
+// digits, it is the same as String without the conversion to a string.
+func (b *BigInt) ToDecimalBytes() []byte {
+	b = b.orZero()
+
+	return b.appendDecimal(make([]byte, 0, len(b.magnitude)*b.chunkSize()+1))
+}
 
-		// Get the chunk value from the right
-		// If the right chunk does not exist, use 0
-		if rhsIndex >= 0 {
-			rhsChunk = rhs[rhsIndex]
+// AppendString appends the decimal representation of the BigInt to dst and
+// returns the extended slice, like strconv.AppendInt. Appending many values
+// to the same buffer avoids building a string for each one.
+func (b *BigInt) AppendString(dst []byte) []byte {
+	return b.appendDecimal(dst)
+}
+
+// appendDecimal appends the decimal representation of the BigInt to dst,
+// the negative values start with a '-' sign.
+func (b *BigInt) appendDecimal(dst []byte) []byte {
+	b = b.orZero()
+
+	if b.negative {
+		dst = append(dst, '-')
+	}
+
+	start := len(dst)
+
+	for _, chunk := range normalizeMagnitude(b.magnitude, b.base()) {
+		// Skip the leading zero chunks
+		if len(dst) == start && chunk == 0 {
+			continue
 		}
 
-		// Add the two chunks
-		sum := lhsChunk + rhsChunk
+		dst = appendChunk(dst, chunk, b.chunkSize(), len(dst) > start)
+	}
+
+	if len(dst) == start {
+		dst = append(dst, '0')
+	}
+
+	return dst
+}
 
-		// Add the carry to the sum
-		if carry {
-			sum++
+// appendChunk appends the digits of a chunk to dst, when padded is true
+// the chunk is padded with leading zeros up to chunkSize digits.
+func appendChunk(dst []byte, chunk uint64, chunkSize int, padded bool) []byte {
+	if padded && chunkSize > 0 {
+		for limit := powersOfTen[chunkSize-1]; limit > chunk && limit > 1; limit /= 10 {
+			dst = append(dst, '0')
 		}
+	}
+
+	return strconv.AppendUint(dst, chunk, 10)
+}
 
-		// If the sum doesn't fit in a chunk,
-		// we need to carry to the next addition
-		exponential := uint32(math.Pow10(b.chukSize))
-		carry = sum/exponential > 0
+// GoString returns a Go-syntax representation of the BigInt
+// that can be pasted back into the code.
+func (b *BigInt) GoString() string {
+	return "bignumber.MustNewBigInt(" + strconv.Quote(b.String()) + ")"
+}
+
+// Key returns a canonical string for the BigInt that can be used as a map key,
+// two equal values have the same key regardless of how they are stored.
+func (b *BigInt) Key() string {
+	// INFO: the decimal representation skips the leading zero chunks
+	// and doesn't depend on the chunk size
+	return b.String()
+}
+
+// Add adds two BigInts and returns the result.
+func (b *BigInt) Add(other *BigInt) *BigInt {
+	b = b.orZero()
+
+	// A nil operand is treated as zero
+	other = other.inChunksOf(b.chunkSize())
+
+	sum := addSigned(b.signed(), other.signed(), b.base())
+
+	return newBigIntFromSigned(sum, b.chunkSize())
+}
+
+// AddInPlace adds other to the BigInt, modifying the receiver. The receiver
+// chunks are reused unless the result needs more chunks than the receiver has.
+func (b *BigInt) AddInPlace(other *BigInt) error {
+	if b == nil {
+		panic("bignumber: cannot add in place to a nil *BigInt")
+	}
+
+	if b.frozen {
+		return ErrFrozen
+	}
 
-		if carry {
-			// Remove the carry from the sum
-			sum %= exponential
+	// A nil operand is treated as zero
+	b.addInPlace(other.inChunksOf(b.chunkSize()), false)
+
+	return nil
+}
+
+// addInPlace adds other to the BigInt in place, or subtracts it when
+// subtract is true. Both must be stored in the same chunk size.
+func (b *BigInt) addInPlace(other *BigInt, subtract bool) {
+	// Subtracting is adding the opposite
+	otherNegative := other.negative != subtract
+
+	// Both magnitudes are normalized so every chunk fits in the base
+	b.magnitude = normalizeMagnitude(b.magnitude, b.base())
+	magnitude := normalizeMagnitude(other.magnitude, b.base())
+
+	switch {
+	case b.negative == otherNegative:
+		if len(b.magnitude) < len(magnitude) {
+			b.magnitude = addMagnitudes(b.magnitude, magnitude, b.base())
+
+			break
+		}
+
+		if carry := addMagnitudeInPlace(b.magnitude, magnitude, b.base()); carry != 0 {
+			b.magnitude = append([]uint64{carry}, b.magnitude...)
 		}
+	case cmpMagnitudes(b.magnitude, magnitude) >= 0:
+		subMagnitudeInPlace(b.magnitude, magnitude, b.base())
+	default:
+		// The largest magnitude is the one of other, so the result takes its sign
+		b.magnitude = subMagnitudes(magnitude, b.magnitude, b.base())
+		b.negative = otherNegative
+	}
+
+	b.magnitude = trimLeadingZeroChunks(b.magnitude)
+	b.length = b.digits()
+	b.negative = b.negative && !isZeroMagnitude(b.magnitude)
+}
+
+// Sub subtracts other from the BigInt and returns the result, which is
+// negative when other is greater than the BigInt, Ex: 5 - 8 is -3.
+func (b *BigInt) Sub(other *BigInt) *BigInt {
+	b = b.orZero()
+
+	// A nil operand is treated as zero
+	other = other.inChunksOf(b.chunkSize())
+
+	difference := subSigned(b.signed(), other.signed(), b.base())
 
-		// Store the sum in the result
-		result.magnitude[lhsIndex] = sum
+	return newBigIntFromSigned(difference, b.chunkSize())
+}
+
+// SubInPlace subtracts other from the BigInt, modifying the receiver.
+// Like Sub, the receiver becomes negative when other is greater than it.
+// It only returns ErrFrozen for the frozen values.
+func (b *BigInt) SubInPlace(other *BigInt) error {
+	if b == nil {
+		panic("bignumber: cannot subtract in place from a nil *BigInt")
 	}
 
-	// If we have a carry left, we need to add a new chunk
-	if carry {
-		newMagnitude := make([]uint32, len(result.magnitude)+1)
-		newMagnitude[0] = 1
-		copy(newMagnitude[1:], result.magnitude)
-		result.magnitude = newMagnitude
+	if b.frozen {
+		return ErrFrozen
 	}
 
-	// TODO: Investigate if we can compute the length while computing the sum
-	result.length = len(result.String())
+	// A nil operand is treated as zero
+	b.addInPlace(other.inChunksOf(b.chunkSize()), true)
+
+	return nil
+}
+
+// Mul multiplies two BigInts and returns the result.
+func (b *BigInt) Mul(other *BigInt) *BigInt {
+	b = b.orZero()
+
+	// A nil operand is treated as zero
+	other = other.inChunksOf(b.chunkSize())
+
+	// INFO: the long multiplication needs chunks lower than the base, the
+	// chunks that don't fit in the chunk size are carried first
+	lhs, rhs := b.signed(), other.signed()
+	magnitude := mulMagnitudes(lhs.magnitude, rhs.magnitude, b.base())
+
+	return newBigIntFromSigned(signedMagnitude{magnitude, lhs.negative != rhs.negative}, b.chunkSize())
+}
+
+// QuoRem divides the BigInt by other and returns the quotient truncated toward
+// zero and the remainder, which takes the sign of the BigInt, like `big.Int.QuoRem`.
+// They satisfy b = quotient*other + remainder, Ex: -7 / 2 is -3 and -1.
+// It returns ErrDivisionByZero when other is zero.
+func (b *BigInt) QuoRem(other *BigInt) (*BigInt, *BigInt, error) {
+	b = b.orZero()
+
+	// A nil operand is treated as zero
+	other = other.inChunksOf(b.chunkSize())
+
+	// INFO: the long division needs chunks lower than the base, the
+	// chunks that don't fit in the chunk size are carried first
+	lhs, rhs := b.signed(), other.signed()
+
+	if isZeroMagnitude(rhs.magnitude) {
+		return nil, nil, ErrDivisionByZero
+	}
+
+	quotient, remainder := quoRemMagnitudes(lhs.magnitude, rhs.magnitude, b.base())
+
+	return newBigIntFromSigned(signedMagnitude{quotient, lhs.negative != rhs.negative}, b.chunkSize()),
+		newBigIntFromSigned(signedMagnitude{remainder, lhs.negative}, b.chunkSize()), nil
+}
+
+// Quo returns the quotient of QuoRem, like `big.Int.Quo`.
+// It returns ErrDivisionByZero when other is zero.
+func (b *BigInt) Quo(other *BigInt) (*BigInt, error) {
+	quotient, _, err := b.QuoRem(other)
+
+	return quotient, err
+}
+
+// Rem returns the remainder of QuoRem, like `big.Int.Rem`.
+// It returns ErrDivisionByZero when other is zero.
+func (b *BigInt) Rem(other *BigInt) (*BigInt, error) {
+	_, remainder, err := b.QuoRem(other)
+
+	return remainder, err
+}
+
+// Div divides the BigInt by other and returns the quotient truncated
+// toward zero, it is the same as Quo, Ex: -7 / 2 is -3.
+// It returns ErrDivisionByZero when other is zero.
+func (b *BigInt) Div(other *BigInt) (*BigInt, error) {
+	return b.Quo(other)
+}
+
+// Mod divides the BigInt by other and returns the remainder of the
+// truncated division, it is the same as Rem. The remainder takes the sign
+// of the BigInt, so b = b.Div(other)*other + b.Mod(other), Ex: -7 mod 2 is -1.
+// It returns ErrDivisionByZero when other is zero.
+func (b *BigInt) Mod(other *BigInt) (*BigInt, error) {
+	return b.Rem(other)
+}
+
+// EuclideanMod returns the Euclidean remainder of the BigInt divided by other,
+// which always lies in [0, |other|), like `big.Int.Mod`. Unlike Mod, whose
+// remainder truncates toward zero and takes the sign of the dividend, the
+// result is never negative, which makes it the one to use for hashing or
+// modular indexes, Ex: -7 mod 2 is 1 and -7 mod -2 is 1 too.
+// It returns ErrDivisionByZero when other is zero.
+func (b *BigInt) EuclideanMod(other *BigInt) (*BigInt, error) {
+	remainder, err := b.Mod(other)
+	if err != nil {
+		return nil, err
+	}
+
+	if !remainder.negative {
+		return remainder, nil
+	}
+
+	// INFO: |remainder| < |other|, so a single |other| moves it into [0, |other|)
+	base := remainder.base()
+	divisor := normalizeMagnitude(other.inChunksOf(remainder.chunkSize()).magnitude, base)
+
+	return newBigIntFromMagnitude(subMagnitudes(divisor, remainder.magnitude, base), remainder.chunkSize()), nil
+}
+
+// DivExact divides the BigInt by other when other is known to divide it.
+// It returns ErrDivisionByZero when other is zero, and ErrInexact when the
+// division leaves a remainder.
+func (b *BigInt) DivExact(other *BigInt) (*BigInt, error) {
+	b = b.orZero()
+
+	// A nil operand is treated as zero
+	other = other.inChunksOf(b.chunkSize())
+
+	lhs, rhs := b.signed(), other.signed()
+
+	if isZeroMagnitude(rhs.magnitude) {
+		return nil, ErrDivisionByZero
+	}
+
+	quotient, remainder := quoRemMagnitudes(lhs.magnitude, rhs.magnitude, b.base())
+	if !isZeroMagnitude(remainder) {
+		return nil, ErrInexact
+	}
+
+	return newBigIntFromSigned(signedMagnitude{quotient, b.negative != other.negative}, b.chunkSize()), nil
+}
+
+// ModUint32 returns the BigInt modulo m, it reduces the chunks in a single
+// pass instead of going through Mod. Like EuclideanMod the result is never
+// negative, Ex: -7 mod 3 is 2. It returns ErrDivisionByZero when m is zero.
+func (b *BigInt) ModUint32(m uint32) (uint32, error) {
+	b = b.orZero()
+
+	if m == 0 {
+		return 0, ErrDivisionByZero
+	}
+
+	remainder := uint32(divModUint64(nil, b.signed().magnitude, b.base(), uint64(m)))
+
+	if b.negative && remainder != 0 {
+		return m - remainder, nil
+	}
+
+	return remainder, nil
+}
+
+// MulAdd returns b*factor + addend, it matches b.Mul(factor).Add(addend)
+// but builds the result in a single buffer.
+func (b *BigInt) MulAdd(factor, addend *BigInt) *BigInt {
+	b = b.orZero()
+
+	// A nil operand is treated as zero
+	factor, addend = factor.inChunksOf(b.chunkSize()), addend.inChunksOf(b.chunkSize())
+
+	// INFO: the addend is only added in place when it has the sign of the
+	// product, otherwise it goes through the signed addition
+	negative := b.negative != factor.negative
+	if addend.negative != negative {
+		return b.Mul(factor).Add(addend)
+	}
+
+	lhs, rhs, sum := b.signed(), factor.signed(), addend.signed()
+
+	// INFO: the extra chunk holds the carry of the addition,
+	// so adding in place can never overflow the buffer
+	size := len(lhs.magnitude) + len(rhs.magnitude)
+	result := make([]uint64, max(size, len(sum.magnitude))+1)
+
+	mulMagnitudesInto(result[len(result)-size:], lhs.magnitude, rhs.magnitude, b.base())
+	addMagnitudeInPlace(result, sum.magnitude, b.base())
+
+	return newBigIntFromSigned(signedMagnitude{result, negative}, b.chunkSize())
+}
+
+// MulScalar multiplies the BigInt by an uint64 and returns the result.
+func (b *BigInt) MulScalar(factor uint64) *BigInt {
+	b = b.orZero()
+
+	magnitude := mulMagnitudeUint64(normalizeMagnitude(b.magnitude, b.base()), b.base(), factor)
+
+	return newBigIntFromSigned(signedMagnitude{magnitude, b.negative}, b.chunkSize())
+}
+
+// Inc returns the BigInt plus one.
+func (b *BigInt) Inc() *BigInt {
+	b = b.orZero()
+
+	sum := addSigned(b.signed(), signedMagnitude{[]uint64{1}, false}, b.base())
+
+	return newBigIntFromSigned(sum, b.chunkSize())
+}
+
+// Half returns the BigInt divided by two, truncated toward zero like Div.
+func (b *BigInt) Half() *BigInt {
+	b = b.orZero()
+
+	value := b.signed()
+
+	quotient := make([]uint64, len(value.magnitude))
+	divModUint64(quotient, value.magnitude, b.base(), 2)
+
+	return newBigIntFromSigned(signedMagnitude{quotient, value.negative}, b.chunkSize())
+}
+
+// Double returns the BigInt times two.
+func (b *BigInt) Double() *BigInt {
+	return b.MulScalar(2)
+}
+
+// IsEven reports whether the BigInt is divisible by two.
+func (b *BigInt) IsEven() bool {
+	b = b.orZero()
+
+	// INFO: the base is a power of ten, so the parity is the one of the last chunk
+	return len(b.magnitude) == 0 || b.magnitude[len(b.magnitude)-1]%2 == 0
+}
+
+// IsOdd reports whether the BigInt is not divisible by two.
+func (b *BigInt) IsOdd() bool {
+	return !b.IsEven()
+}
+
+// IsZero reports whether the BigInt is zero, a nil BigInt is zero.
+func (b *BigInt) IsZero() bool {
+	return isZeroMagnitude(b.orZero().magnitude)
+}
+
+// Sign returns:
+//
+//	-1 if b <  0
+//	 0 if b == 0
+//	+1 if b >  0
+func (b *BigInt) Sign() int {
+	switch {
+	case b.IsZero():
+		return 0
+	case b.negative:
+		return -1
+	}
+
+	return 1
+}
+
+// IsOne reports whether the BigInt is one, the leading zero chunks are ignored.
+func (b *BigInt) IsOne() bool {
+	b = b.orZero()
+
+	magnitude := normalizeMagnitude(b.magnitude, b.base())
+
+	return !b.negative && len(magnitude) == 1 && magnitude[0] == 1
+}
+
+// trimLeadingZeroChunks returns the magnitude without the leading zero chunks,
+// it always keeps at least one chunk so zero is represented as [0].
+func trimLeadingZeroChunks(magnitude []uint64) []uint64 {
+	// INFO: the zero value BigInt has no chunks at all
+	if len(magnitude) == 0 {
+		return []uint64{0}
+	}
+
+	for len(magnitude) > 1 && magnitude[0] == 0 {
+		magnitude = magnitude[1:]
+	}
 
-	return result
+	return magnitude
 }
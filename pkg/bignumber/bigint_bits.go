@@ -0,0 +1,48 @@
+package bignumber
+
+import "math/bits"
+
+// BitLen returns the length of the absolute value of the BigInt in bits.
+// The bit length of 0 is 0.
+func (b *BigInt) BitLen() int {
+	b = b.orZero()
+
+	return wordsBitLen(toWords(b.magnitude, b.base()))
+}
+
+// Log2 returns the floor of the logarithm in base 2 of the absolute value
+// of the BigInt, which is BitLen() - 1, Ex: 1023 returns 9 and 1024 returns 10.
+//
+// The logarithm of 0 is undefined, so it returns ErrLogOfZero.
+func (b *BigInt) Log2() (int, error) {
+	bitLen := b.BitLen()
+	if bitLen == 0 {
+		return 0, ErrLogOfZero
+	}
+
+	return bitLen - 1, nil
+}
+
+// TestBit returns the value of the i'th bit of the BigInt, the bit index must be non-negative.
+func (b *BigInt) TestBit(i int) uint {
+	b = b.orZero()
+
+	if i < 0 {
+		panic("bignumber: negative bit index")
+	}
+
+	return testWordBit(toWords(b.magnitude, b.base()), i)
+}
+
+// CountSetBits returns the number of one bits in the binary representation of the BigInt.
+func (b *BigInt) CountSetBits() int {
+	b = b.orZero()
+
+	var count int
+
+	for _, word := range toWords(b.magnitude, b.base()) {
+		count += bits.OnesCount32(word)
+	}
+
+	return count
+}
@@ -0,0 +1,104 @@
+package bignumber
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+var bitsInputs = []string{
+	"0",
+	"1",
+	"2",
+	"255",
+	"256",
+	"1000000000",
+	"4294967295",
+	"4294967296",
+	"18446744073709551616",
+	"123456789012345678901234567890",
+}
+
+func TestBigIntBitLen(t *testing.T) {
+	for idx, input := range bitsInputs {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			want, _ := new(big.Int).SetString(input, 10)
+
+			if got := MustNewBigInt(input).BitLen(); got != want.BitLen() {
+				t.Errorf("got %v, want %v", got, want.BitLen())
+			}
+		})
+	}
+}
+
+func TestBigIntLog2(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for idx := range 200 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		input := randomNumber(random, 1+random.Intn(200))
+		chunkSize := 1 + random.Intn(maxChunkSize)
+
+		t.Run(testname, func(t *testing.T) {
+			want, _ := new(big.Int).SetString(input, 10)
+
+			got, err := MustNewBigInt(input, WithChunkSize(chunkSize)).Log2()
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got != want.BitLen()-1 {
+				t.Errorf("got %v, want %v", got, want.BitLen()-1)
+			}
+		})
+	}
+}
+
+func TestBigIntLog2Zero(t *testing.T) {
+	for _, value := range []*BigInt{MustNewBigInt("0"), MustNewBigInt("000"), {}, nil} {
+		if _, err := value.Log2(); err != ErrLogOfZero {
+			t.Errorf("got %v, want %v", err, ErrLogOfZero)
+		}
+	}
+}
+
+func TestBigIntTestBit(t *testing.T) {
+	for idx, input := range bitsInputs {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			bg := MustNewBigInt(input)
+			want, _ := new(big.Int).SetString(input, 10)
+
+			for bit := 0; bit <= want.BitLen()+1; bit++ {
+				if got := bg.TestBit(bit); got != want.Bit(bit) {
+					t.Errorf("bit %d: got %v, want %v", bit, got, want.Bit(bit))
+				}
+			}
+		})
+	}
+}
+
+func TestBigIntCountSetBits(t *testing.T) {
+	for idx, input := range bitsInputs {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value, _ := new(big.Int).SetString(input, 10)
+
+			var want int
+			for _, word := range value.Bits() {
+				want += bits.OnesCount(uint(word))
+			}
+
+			if got := MustNewBigInt(input).CountSetBits(); got != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
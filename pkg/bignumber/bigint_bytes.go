@@ -0,0 +1,170 @@
+package bignumber
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// NewBigIntFromBytes creates a new BigInt from the big-endian
+// base 256 representation of an unsigned number, just like `big.Int.SetBytes`.
+// An empty slice is 0.
+func NewBigIntFromBytes(data []byte) *BigInt {
+	base := powersOfTen[maxChunkSize]
+	magnitude := []uint64{0}
+
+	// INFO: the leading bytes that don't fill a whole word go first,
+	// so the rest of the data can be appended 32 bits at a time
+	head := len(data) % 4
+
+	if head > 0 {
+		var word [4]byte
+		copy(word[4-head:], data[:head])
+
+		magnitude = magnitudeFromUint64(uint64(binary.BigEndian.Uint32(word[:])), base)
+	}
+
+	for offset := head; offset < len(data); offset += 4 {
+		word := binary.BigEndian.Uint32(data[offset : offset+4])
+
+		magnitude = mulMagnitudeUint64(magnitude, base, 1<<32)
+		magnitude = addMagnitudes(magnitude, magnitudeFromUint64(uint64(word), base), base)
+	}
+
+	return newBigIntFromMagnitude(magnitude, maxChunkSize)
+}
+
+// Bytes returns the shortest big-endian base 256 representation of the
+// absolute value of the BigInt, just like `big.Int.Bytes`.
+// The representation of 0 is an empty slice.
+func (b *BigInt) Bytes() []byte {
+	b = b.orZero()
+
+	words := toWords(b.magnitude, b.base())
+	data := make([]byte, 4*len(words))
+
+	// The words go from the least significant one, so they are written backwards
+	for idx, word := range words {
+		binary.BigEndian.PutUint32(data[len(data)-4*(idx+1):], word)
+	}
+
+	// Skip the leading zero bytes of the most significant word
+	for len(data) > 0 && data[0] == 0 {
+		data = data[1:]
+	}
+
+	return data
+}
+
+// NewBigIntFromBytesLE is like NewBigIntFromBytes but
+// the data is little-endian, the least significant byte first.
+func NewBigIntFromBytesLE(data []byte) *BigInt {
+	// INFO: the data is reversed on a copy to leave the caller slice untouched
+	reversed := slices.Clone(data)
+	slices.Reverse(reversed)
+
+	return NewBigIntFromBytes(reversed)
+}
+
+// BytesLE is like Bytes but the result is little-endian,
+// the least significant byte first. The representation of 0 is an empty slice.
+func (b *BigInt) BytesLE() []byte {
+	data := b.Bytes()
+	slices.Reverse(data)
+
+	return data
+}
+
+// NewBigIntFromWords creates a new BigInt from the big-endian base 2^64
+// words of an unsigned number, the inverse of Words. An empty slice is 0.
+func NewBigIntFromWords(words []uint64) *BigInt {
+	data := make([]byte, 0, 8*len(words))
+
+	for _, word := range words {
+		data = binary.BigEndian.AppendUint64(data, word)
+	}
+
+	return NewBigIntFromBytes(data)
+}
+
+// Words returns the shortest big-endian base 2^64 representation of the
+// absolute value of the BigInt, the most significant word first. Unlike
+// `big.Int.Bits` the words are a copy and are always 64 bits wide.
+// The representation of 0 is an empty slice.
+func (b *BigInt) Words() []uint64 {
+	b = b.orZero()
+
+	halves := toWords(b.magnitude, b.base())
+	words := make([]uint64, (len(halves)+1)/2)
+
+	// INFO: the halves go from the least significant one, so every pair
+	// fills a word from the end, the last half may be alone in its word
+	for idx, half := range halves {
+		words[len(words)-1-idx/2] |= uint64(half) << (32 * (idx % 2))
+	}
+
+	return words
+}
+
+// TwosComplement returns the big-endian two's complement representation of
+// the BigInt in a field of the given number of bits, which must be a positive
+// multiple of eight. It returns ErrOutOfRange for any other width, and
+// ErrOverflow when the value doesn't fit in the field, which holds values
+// from -2^(bits-1) up to 2^(bits-1) - 1.
+func (b *BigInt) TwosComplement(bits int) ([]byte, error) {
+	if bits <= 0 || bits%8 != 0 {
+		return nil, ErrOutOfRange
+	}
+
+	if b.BitLen() > bits {
+		return nil, ErrOverflow
+	}
+
+	data := make([]byte, bits/8)
+	magnitude := b.Bytes()
+
+	copy(data[len(data)-len(magnitude):], magnitude)
+
+	if b.orZero().negative {
+		negateBytes(data)
+	}
+
+	// INFO: the sign bit must match the sign of the value, otherwise the
+	// value needs one more bit, Ex: 128 or -129 in 8 bits
+	if (data[0]&0x80 != 0) != b.orZero().negative {
+		return nil, ErrOverflow
+	}
+
+	return data, nil
+}
+
+// NewBigIntFromTwosComplement creates a new BigInt from a big-endian two's
+// complement representation, the inverse of TwosComplement. An empty slice is 0
+// and the value is negative when the sign bit is set.
+func NewBigIntFromTwosComplement(data []byte) *BigInt {
+	if len(data) == 0 || data[0]&0x80 == 0 {
+		return NewBigIntFromBytes(data)
+	}
+
+	// The absolute value is the two's complement of the data
+	magnitude := slices.Clone(data)
+	negateBytes(magnitude)
+
+	abs := NewBigIntFromBytes(magnitude)
+
+	return newBigIntFromSigned(signedMagnitude{abs.magnitude, true}, abs.chunkSize())
+}
+
+// negateBytes replaces the big-endian data with its two's complement,
+// inverting the bits and adding one.
+func negateBytes(data []byte) {
+	carry := true
+
+	for idx := len(data) - 1; idx >= 0; idx-- {
+		data[idx] = ^data[idx]
+
+		if carry {
+			data[idx]++
+			carry = data[idx] == 0
+		}
+	}
+}
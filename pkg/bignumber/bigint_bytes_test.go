@@ -0,0 +1,355 @@
+package bignumber
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestBigIntBytes(t *testing.T) {
+	tests := []string{
+		"0",
+		"1",
+		"255",
+		"256",
+		"4294967295",
+		"4294967296",
+		"1000000000",
+		"18446744073709551616",
+		"123456789012345678901234567890",
+		"340282366920938463463374607431768211455",
+		"000000000000000000000000000123",
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value, _ := new(big.Int).SetString(tc, 10)
+			want := value.Bytes()
+
+			got := MustNewBigInt(tc).Bytes()
+			if !bytes.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+
+			// The encoding must round trip back to the same value
+			if roundTrip := NewBigIntFromBytes(got); roundTrip.String() != value.String() {
+				t.Errorf("got %v, want %v", roundTrip.String(), value.String())
+			}
+		})
+	}
+}
+
+func TestNewBigIntFromBytes(t *testing.T) {
+	tests := []struct {
+		input []byte
+		want  string
+	}{
+		{
+			input: nil,
+			want:  "0",
+		},
+		{
+			input: []byte{0, 0, 0},
+			want:  "0",
+		},
+		{
+			input: []byte{1, 0},
+			want:  "256",
+		},
+		{
+			input: []byte{0, 0, 0, 0, 0, 1, 0, 0, 0, 0},
+			want:  "4294967296",
+		},
+		{
+			input: bytes.Repeat([]byte{0xff}, 16),
+			want:  "340282366920938463463374607431768211455",
+		},
+		{
+			input: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13},
+			want:  new(big.Int).SetBytes([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13}).String(),
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := NewBigIntFromBytes(tc.input); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntBytesLE(t *testing.T) {
+	tests := []string{
+		"0",
+		"1",
+		"256",
+		"4294967296",
+		"123456789012345678901234567890",
+		"340282366920938463463374607431768211455",
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value, _ := new(big.Int).SetString(tc, 10)
+
+			// The little-endian bytes reversed are the math/big encoding
+			got := MustNewBigInt(tc).BytesLE()
+			reversed := slices.Clone(got)
+			slices.Reverse(reversed)
+
+			if want := value.Bytes(); !bytes.Equal(reversed, want) {
+				t.Errorf("got %v, want %v", reversed, want)
+			}
+
+			if roundTrip := NewBigIntFromBytesLE(got); roundTrip.String() != value.String() {
+				t.Errorf("got %v, want %v", roundTrip.String(), value.String())
+			}
+		})
+	}
+}
+
+func TestNewBigIntFromBytesLE(t *testing.T) {
+	data := []byte{13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 0}
+
+	reversed := slices.Clone(data)
+	slices.Reverse(reversed)
+	want := new(big.Int).SetBytes(reversed).String()
+
+	if got := NewBigIntFromBytesLE(data); got.String() != want {
+		t.Errorf("got %v, want %v", got.String(), want)
+	}
+
+	// The input must not be modified
+	if data[0] != 13 {
+		t.Errorf("got %v, want %v", data[0], 13)
+	}
+}
+
+func TestBigIntWords(t *testing.T) {
+	tests := []string{
+		"0",
+		"1",
+		"4294967295",
+		"4294967296",
+		"18446744073709551615",
+		"18446744073709551616",
+		"123456789012345678901234567890",
+		"340282366920938463463374607431768211455",
+		"340282366920938463463374607431768211456",
+		"000000000000000000000000000123",
+	}
+
+	random := rand.New(rand.NewSource(1))
+	for range 50 {
+		tests = append(tests, randomNumber(random, 1+random.Intn(200)))
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value, _ := new(big.Int).SetString(tc, 10)
+
+			// The words of big.Int from the most significant one
+			var want []uint64
+			for rest := new(big.Int).Set(value); rest.Sign() > 0; rest.Rsh(rest, 64) {
+				want = append([]uint64{rest.Uint64()}, want...)
+			}
+
+			got := MustNewBigInt(tc, WithChunkSize(1+idx%maxChunkSize)).Words()
+			if !slices.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+
+			// The words must round trip back to the same value
+			if roundTrip := NewBigIntFromWords(got); roundTrip.String() != value.String() {
+				t.Errorf("got %v, want %v", roundTrip.String(), value.String())
+			}
+		})
+	}
+}
+
+func TestBigIntWordsIsACopy(t *testing.T) {
+	value := MustNewBigInt("340282366920938463463374607431768211455")
+
+	words := value.Words()
+	words[0] = 0
+
+	if got := value.String(); got != "340282366920938463463374607431768211455" {
+		t.Errorf("got %v, want %v", got, "340282366920938463463374607431768211455")
+	}
+}
+
+func TestBigIntTwosComplement(t *testing.T) {
+	tests := []struct {
+		input string
+		bits  int
+		want  []byte
+		err   error
+	}{
+		{
+			input: "0",
+			bits:  8,
+			want:  []byte{0x00},
+			err:   nil,
+		},
+		{
+			input: "127",
+			bits:  8,
+			want:  []byte{0x7f},
+			err:   nil,
+		},
+		{
+			input: "128",
+			bits:  8,
+			want:  nil,
+			err:   ErrOverflow,
+		},
+		{
+			input: "128",
+			bits:  16,
+			want:  []byte{0x00, 0x80},
+			err:   nil,
+		},
+		{
+			input: "9223372036854775807",
+			bits:  64,
+			want:  []byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			err:   nil,
+		},
+		{
+			input: "9223372036854775808",
+			bits:  64,
+			want:  nil,
+			err:   ErrOverflow,
+		},
+		{
+			input: "-1",
+			bits:  8,
+			want:  []byte{0xff},
+			err:   nil,
+		},
+		{
+			input: "-128",
+			bits:  8,
+			want:  []byte{0x80},
+			err:   nil,
+		},
+		{
+			input: "-129",
+			bits:  8,
+			want:  nil,
+			err:   ErrOverflow,
+		},
+		{
+			input: "-256",
+			bits:  8,
+			want:  nil,
+			err:   ErrOverflow,
+		},
+		{
+			input: "-129",
+			bits:  16,
+			want:  []byte{0xff, 0x7f},
+			err:   nil,
+		},
+		{
+			input: "-9223372036854775808",
+			bits:  64,
+			want:  []byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			err:   nil,
+		},
+		{
+			input: "1",
+			bits:  12,
+			want:  nil,
+			err:   ErrOutOfRange,
+		},
+		{
+			input: "0",
+			bits:  0,
+			want:  nil,
+			err:   ErrOutOfRange,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(tc.input).TwosComplement(tc.bits)
+			if err != tc.err {
+				t.Fatalf("got %v, want %v", err, tc.err)
+			}
+
+			if !bytes.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			// The encoding must round trip back to the same value
+			roundTrip := NewBigIntFromTwosComplement(got)
+
+			if roundTrip.String() != tc.input {
+				t.Errorf("got %v, want %v", roundTrip.String(), tc.input)
+			}
+		})
+	}
+}
+
+func TestNewBigIntFromTwosComplement(t *testing.T) {
+	tests := []struct {
+		input []byte
+		want  string
+	}{
+		{
+			input: nil,
+			want:  "0",
+		},
+		{
+			input: []byte{0x00, 0x00, 0x01},
+			want:  "1",
+		},
+		{
+			input: []byte{0x7f, 0xff},
+			want:  "32767",
+		},
+		{
+			input: []byte{0x80, 0x00},
+			want:  "-32768",
+		},
+		{
+			input: []byte{0xff},
+			want:  "-1",
+		},
+		{
+			input: []byte{0xff, 0xff, 0x7f},
+			want:  "-129",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := NewBigIntFromTwosComplement(tc.input)
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package bignumber
+
+import "strconv"
+
+// chunkDigits returns the number of digits of a chunk without
+// leading zeros, 0 has a single digit.
+func chunkDigits(chunk uint64) int {
+	// INFO: counting against the powers of ten avoids formatting the chunk
+	digits := 1
+	for digits <= maxChunkSize && chunk >= powersOfTen[digits] {
+		digits++
+	}
+
+	return digits
+}
+
+// digits returns the number of significant digits in the BigInt,
+// leading zero chunks and leading zeros are not counted.
+func (b *BigInt) digits() int {
+	b = b.orZero()
+
+	magnitude := normalizeMagnitude(b.magnitude, b.base())
+
+	for idx, chunk := range magnitude {
+		if chunk == 0 {
+			continue
+		}
+
+		first := chunkDigits(chunk)
+		rest := len(magnitude) - idx - 1
+
+		return first + rest*b.chunkSize()
+	}
+
+	// INFO: zero has a single digit
+	return 1
+}
+
+// CmpInt64 compares the BigInt with an int64 and returns:
+//
+//	-1 if b <  n
+//	 0 if b == n
+//	+1 if b >  n
+func (b *BigInt) CmpInt64(n int64) int {
+	b = b.orZero()
+
+	// The values with different signs compare by their sign
+	switch {
+	case b.negative && n >= 0:
+		return -1
+	case !b.negative && n < 0:
+		return 1
+	}
+
+	// INFO: the negation wraps around, so it is also right for math.MinInt64
+	abs := uint64(n)
+	if n < 0 {
+		abs = -abs
+	}
+
+	cmp := b.cmpAbsUint64(abs)
+
+	// Between negative values the largest magnitude is the smallest value
+	if b.negative {
+		return -cmp
+	}
+
+	return cmp
+}
+
+// cmpAbsUint64 compares the absolute value of the BigInt with n.
+func (b *BigInt) cmpAbsUint64(n uint64) int {
+	// Compare by digit count first to avoid reassembling big values
+	lhsDigits, rhsDigits := b.digits(), len(strconv.FormatUint(n, 10))
+
+	switch {
+	case lhsDigits < rhsDigits:
+		return -1
+	case lhsDigits > rhsDigits:
+		return 1
+	}
+
+	// INFO: both numbers have at most 20 digits and the same count, so
+	// the magnitude of b fits in an uint64
+	abs := &BigInt{magnitude: b.magnitude, chukSize: b.chukSize}
+	lhs, _ := abs.ToUint64()
+
+	switch {
+	case lhs < n:
+		return -1
+	case lhs > n:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// EqualInt64 reports whether the BigInt is equal to an int64.
+func (b *BigInt) EqualInt64(n int64) bool {
+	return b.CmpInt64(n) == 0
+}
+
+// Cmp compares the BigInt with other and returns:
+//
+//	-1 if b <  other
+//	 0 if b == other
+//	+1 if b >  other
+//
+// Leading zeros are ignored, so 007 and 7 are equal.
+func (b *BigInt) Cmp(other *BigInt) int {
+	b, other = b.orZero(), other.orZero()
+
+	// The values with different signs compare by their sign, zero is never negative
+	switch {
+	case b.negative && !other.negative:
+		return -1
+	case !b.negative && other.negative:
+		return 1
+	case b.negative:
+		// INFO: between negative values the largest magnitude is the smallest value
+		return other.CmpAbs(b)
+	}
+
+	return b.CmpAbs(other)
+}
+
+// CmpString compares the BigInt with the decimal number in value, which follows
+// the same rules as NewBigInt, without building a BigInt for it. It returns
+// -1, 0 or +1 like Cmp, or the parse error of NewBigInt when value is invalid.
+func (b *BigInt) CmpString(value string) (int, error) {
+	b = b.orZero()
+
+	start, end, negative, err := decimalDigits(value)
+	if err != nil {
+		return 0, err
+	}
+
+	for start < end-1 && value[start] == '0' {
+		start++
+	}
+
+	// INFO: -0 is zero, which is never negative
+	negative = negative && value[start:end] != "0"
+
+	// The values with different signs compare by their sign
+	switch {
+	case b.negative && !negative:
+		return -1, nil
+	case !b.negative && negative:
+		return 1, nil
+	}
+
+	cmp := b.cmpAbsDigits(value[start:end])
+
+	// Between negative values the largest magnitude is the smallest value
+	if b.negative {
+		return -cmp, nil
+	}
+
+	return cmp, nil
+}
+
+// cmpAbsDigits compares the absolute value of the BigInt with the decimal
+// digits, which have no leading zeros.
+func (b *BigInt) cmpAbsDigits(digits string) int {
+	// INFO: the chunks are normalized so the digit count matches the digits
+	abs := &BigInt{magnitude: normalizeMagnitude(b.magnitude, b.base()), chukSize: b.chukSize}
+
+	// Compare by digit count first, the digits are only read on a tie
+	switch count := abs.digits(); {
+	case count < len(digits):
+		return -1
+	case count > len(digits):
+		return 1
+	}
+
+	idx := 0
+	for digit := range abs.Digits() {
+		switch other := int(digits[idx] - '0'); {
+		case digit < other:
+			return -1
+		case digit > other:
+			return 1
+		}
+
+		idx++
+	}
+
+	return 0
+}
+
+// CmpAbs compares the absolute values of b and other, ignoring their sign, and returns:
+//
+//	-1 if |b| <  |other|
+//	 0 if |b| == |other|
+//	+1 if |b| >  |other|
+func (b *BigInt) CmpAbs(other *BigInt) int {
+	b = b.orZero()
+
+	// A nil operand is treated as zero
+	other = other.inChunksOf(b.chunkSize())
+
+	// INFO: chunks that don't fit in the chunk size are carried first,
+	// so the chunk by chunk comparison is consistent with the value
+	lhs := normalizeMagnitude(b.magnitude, b.base())
+	rhs := normalizeMagnitude(other.magnitude, b.base())
+
+	return cmpMagnitudes(lhs, rhs)
+}
+
+// Equal reports whether the BigInt is equal to other. Like Cmp, the chunks
+// are normalized first, so leading zero chunks and chunks that don't fit in
+// the chunk size don't change the result, Ex: 300 equals 100+200.
+func (b *BigInt) Equal(other *BigInt) bool {
+	return b.Cmp(other) == 0
+}
+
+// Less reports whether the BigInt is lower than other.
+func (b *BigInt) Less(other *BigInt) bool {
+	return b.Cmp(other) < 0
+}
+
+// Greater reports whether the BigInt is greater than other.
+func (b *BigInt) Greater(other *BigInt) bool {
+	return b.Cmp(other) > 0
+}
+
+// GreaterThanOrEqual reports whether the BigInt is greater than or equal to other.
+func (b *BigInt) GreaterThanOrEqual(other *BigInt) bool {
+	return b.Cmp(other) >= 0
+}
+
+// LessThanOrEqual reports whether the BigInt is less than or equal to other.
+func (b *BigInt) LessThanOrEqual(other *BigInt) bool {
+	return b.Cmp(other) <= 0
+}
+
+// Between reports whether the BigInt lies in the closed interval [low, high]
+// or in the open interval (low, high) when inclusive is false.
+// It returns false when low is greater than high.
+func (b *BigInt) Between(low, high *BigInt, inclusive bool) bool {
+	if low.Cmp(high) > 0 {
+		return false
+	}
+
+	lowCmp, highCmp := b.Cmp(low), b.Cmp(high)
+
+	if inclusive {
+		return lowCmp >= 0 && highCmp <= 0
+	}
+
+	return lowCmp > 0 && highCmp < 0
+}
+
+// Clamp returns low when the BigInt is lower than low, high when it is
+// greater than high, and the BigInt itself otherwise. The values are
+// returned as they are, no copy is made. It panics when low is greater than high.
+func (b *BigInt) Clamp(low, high *BigInt) *BigInt {
+	if low.Cmp(high) > 0 {
+		panic("bignumber: Clamp with low greater than high")
+	}
+
+	switch {
+	case b.Cmp(low) < 0:
+		return low
+	case b.Cmp(high) > 0:
+		return high
+	}
+
+	return b
+}
@@ -0,0 +1,710 @@
+package bignumber
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestBigIntCmpInt64(t *testing.T) {
+	tests := []struct {
+		lhs  string
+		rhs  int64
+		want int
+	}{
+		{
+			lhs:  "0",
+			rhs:  0,
+			want: 0,
+		},
+		{
+			lhs:  "0",
+			rhs:  -1,
+			want: 1,
+		},
+		{
+			lhs:  "123",
+			rhs:  124,
+			want: -1,
+		},
+		{
+			lhs:  "125",
+			rhs:  124,
+			want: 1,
+		},
+		{
+			lhs:  "000124",
+			rhs:  124,
+			want: 0,
+		},
+		{
+			lhs:  "1000000000",
+			rhs:  999999999,
+			want: 1,
+		},
+		{
+			lhs:  "9223372036854775807",
+			rhs:  math.MaxInt64,
+			want: 0,
+		},
+		{
+			lhs:  "9223372036854775808",
+			rhs:  math.MaxInt64,
+			want: 1,
+		},
+		{
+			lhs:  "123456789012345678901234567890",
+			rhs:  math.MaxInt64,
+			want: 1,
+		},
+		{
+			lhs:  "5",
+			rhs:  -5,
+			want: 1,
+		},
+		{
+			lhs:  "-5",
+			rhs:  0,
+			want: -1,
+		},
+		{
+			lhs:  "-5",
+			rhs:  -5,
+			want: 0,
+		},
+		{
+			lhs:  "-5",
+			rhs:  -4,
+			want: -1,
+		},
+		{
+			lhs:  "-5",
+			rhs:  -6,
+			want: 1,
+		},
+		{
+			lhs:  "-9223372036854775808",
+			rhs:  math.MinInt64,
+			want: 0,
+		},
+		{
+			lhs:  "-9223372036854775809",
+			rhs:  math.MinInt64,
+			want: -1,
+		},
+		{
+			lhs:  "-123456789012345678901234567890",
+			rhs:  math.MinInt64,
+			want: -1,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			bg := MustNewBigInt(tc.lhs)
+
+			got := bg.CmpInt64(tc.rhs)
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+
+			if bg.EqualInt64(tc.rhs) != (tc.want == 0) {
+				t.Errorf("got %v, want %v", bg.EqualInt64(tc.rhs), tc.want == 0)
+			}
+		})
+	}
+}
+
+func TestBigIntCmp(t *testing.T) {
+	tests := []struct {
+		lhs  string
+		rhs  string
+		want int
+	}{
+		{
+			lhs:  "0",
+			rhs:  "0",
+			want: 0,
+		},
+		{
+			lhs:  "123",
+			rhs:  "124",
+			want: -1,
+		},
+		{
+			lhs:  "124",
+			rhs:  "123",
+			want: 1,
+		},
+		{
+			lhs:  "007",
+			rhs:  "7",
+			want: 0,
+		},
+		{
+			lhs:  "000000000000000007",
+			rhs:  "7",
+			want: 0,
+		},
+		{
+			lhs:  "1000000000",
+			rhs:  "999999999",
+			want: 1,
+		},
+		{
+			lhs:  "123456789012345678901234567890",
+			rhs:  "123456789012345678901234567891",
+			want: -1,
+		},
+		{
+			lhs:  "-1",
+			rhs:  "1",
+			want: -1,
+		},
+		{
+			lhs:  "1",
+			rhs:  "-1",
+			want: 1,
+		},
+		{
+			lhs:  "0",
+			rhs:  "-1",
+			want: 1,
+		},
+		{
+			lhs:  "-124",
+			rhs:  "-123",
+			want: -1,
+		},
+		{
+			lhs:  "-123",
+			rhs:  "-124",
+			want: 1,
+		},
+		{
+			lhs:  "-7",
+			rhs:  "-007",
+			want: 0,
+		},
+		{
+			lhs:  "-123456789012345678901234567890",
+			rhs:  "-1",
+			want: -1,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			lhs := MustNewBigInt(tc.lhs)
+			rhs := MustNewBigInt(tc.rhs)
+
+			if got := lhs.Cmp(rhs); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+
+			if got := lhs.GreaterThanOrEqual(rhs); got != (tc.want >= 0) {
+				t.Errorf("got %v, want %v", got, tc.want >= 0)
+			}
+
+			if got := lhs.LessThanOrEqual(rhs); got != (tc.want <= 0) {
+				t.Errorf("got %v, want %v", got, tc.want <= 0)
+			}
+
+			if got := lhs.Less(rhs); got != (tc.want < 0) {
+				t.Errorf("got %v, want %v", got, tc.want < 0)
+			}
+
+			if got := lhs.Greater(rhs); got != (tc.want > 0) {
+				t.Errorf("got %v, want %v", got, tc.want > 0)
+			}
+		})
+	}
+}
+
+func TestBigIntBetween(t *testing.T) {
+	tests := []struct {
+		value     string
+		low       string
+		high      string
+		inclusive bool
+		want      bool
+	}{
+		{
+			value:     "5",
+			low:       "1",
+			high:      "10",
+			inclusive: true,
+			want:      true,
+		},
+		{
+			value:     "5",
+			low:       "1",
+			high:      "10",
+			inclusive: false,
+			want:      true,
+		},
+		{
+			value:     "1",
+			low:       "1",
+			high:      "10",
+			inclusive: true,
+			want:      true,
+		},
+		{
+			value:     "1",
+			low:       "1",
+			high:      "10",
+			inclusive: false,
+			want:      false,
+		},
+		{
+			value:     "10",
+			low:       "1",
+			high:      "10",
+			inclusive: true,
+			want:      true,
+		},
+		{
+			value:     "10",
+			low:       "1",
+			high:      "10",
+			inclusive: false,
+			want:      false,
+		},
+		{
+			value:     "0",
+			low:       "1",
+			high:      "10",
+			inclusive: true,
+			want:      false,
+		},
+		{
+			value:     "11",
+			low:       "1",
+			high:      "10",
+			inclusive: true,
+			want:      false,
+		},
+		{
+			value:     "5",
+			low:       "5",
+			high:      "5",
+			inclusive: true,
+			want:      true,
+		},
+		{
+			value:     "5",
+			low:       "5",
+			high:      "5",
+			inclusive: false,
+			want:      false,
+		},
+		{
+			value:     "5",
+			low:       "10",
+			high:      "1",
+			inclusive: true,
+			want:      false,
+		},
+		{
+			value:     "5",
+			low:       "10",
+			high:      "1",
+			inclusive: false,
+			want:      false,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value, _ := NewBigInt(tc.value)
+			low, _ := NewBigInt(tc.low)
+			high, _ := NewBigInt(tc.high)
+
+			if got := value.Between(low, high, tc.inclusive); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntCmpAddResult(t *testing.T) {
+	tests := []struct {
+		lhs  string
+		rhs  string
+		want string
+	}{
+		{
+			lhs:  "999999999",
+			rhs:  "1",
+			want: "1000000000",
+		},
+		{
+			lhs:  "999999999",
+			rhs:  "999999999",
+			want: "1999999998",
+		},
+		{
+			lhs:  "999999999999999999",
+			rhs:  "1",
+			want: "1000000000000000000",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			sum := MustNewBigInt(tc.lhs).Add(MustNewBigInt(tc.rhs))
+			want := MustNewBigInt(tc.want)
+
+			if got := sum.Cmp(want); got != 0 {
+				t.Errorf("got %v, want %v", got, 0)
+			}
+
+			if got := sum.Between(want, want, true); !got {
+				t.Errorf("got %v, want %v", got, true)
+			}
+		})
+	}
+}
+
+func TestBigIntCmpUnnormalizedChunks(t *testing.T) {
+	// INFO: a chunk that doesn't fit in the chunk size, built by hand
+	lhs := &BigInt{magnitude: []uint64{1000000000000000000}, length: 19, chukSize: maxChunkSize}
+
+	if got := lhs.Cmp(MustNewBigInt("1000000000000000000")); got != 0 {
+		t.Errorf("got %v, want %v", got, 0)
+	}
+
+	if got := lhs.Cmp(MustNewBigInt("999999999999999999")); got != 1 {
+		t.Errorf("got %v, want %v", got, 1)
+	}
+}
+
+func TestBigIntLessGreaterNil(t *testing.T) {
+	var nilBigInt *BigInt
+
+	// A nil BigInt is treated as zero on both sides
+	if !nilBigInt.Less(MustNewBigInt("1")) || nilBigInt.Greater(MustNewBigInt("1")) {
+		t.Errorf("got nil not lower than 1, want lower")
+	}
+
+	if !MustNewBigInt("1").Greater(nil) || MustNewBigInt("-1").Greater(nil) {
+		t.Errorf("got 1 not greater than nil or -1 greater than nil, want only 1 greater")
+	}
+
+	if nilBigInt.Less(NewZero()) || nilBigInt.Greater(NewZero()) {
+		t.Errorf("got nil different from 0, want equal")
+	}
+}
+
+func TestBigIntClamp(t *testing.T) {
+	tests := []struct {
+		value string
+		low   string
+		high  string
+		want  string
+	}{
+		{
+			value: "5",
+			low:   "10",
+			high:  "20",
+			want:  "10",
+		},
+		{
+			value: "25",
+			low:   "10",
+			high:  "20",
+			want:  "20",
+		},
+		{
+			value: "15",
+			low:   "10",
+			high:  "20",
+			want:  "15",
+		},
+		{
+			value: "10",
+			low:   "10",
+			high:  "20",
+			want:  "10",
+		},
+		{
+			value: "20",
+			low:   "10",
+			high:  "20",
+			want:  "20",
+		},
+		{
+			value: "123456789012345678901234567890",
+			low:   "7",
+			high:  "7",
+			want:  "7",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := MustNewBigInt(tc.value).Clamp(MustNewBigInt(tc.low), MustNewBigInt(tc.high))
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntClampPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("got no panic, want panic")
+		}
+	}()
+
+	MustNewBigInt("15").Clamp(MustNewBigInt("20"), MustNewBigInt("10"))
+}
+
+func TestBigIntCmpAbs(t *testing.T) {
+	tests := []struct {
+		lhs  *BigInt
+		rhs  *BigInt
+		want int
+	}{
+		{
+			lhs:  MustNewBigInt("7"),
+			rhs:  MustNewBigInt("007"),
+			want: 0,
+		},
+		{
+			lhs:  MustNewBigInt("123456789012345678901234567890"),
+			rhs:  MustNewBigInt("123456789012345678901234567891"),
+			want: -1,
+		},
+		{
+			lhs:  MustNewBigInt("1000000000"),
+			rhs:  MustNewBigInt("999999999"),
+			want: 1,
+		},
+		{
+			lhs:  &BigInt{magnitude: []uint64{1, 1000000000000000000}},
+			rhs:  MustNewBigInt("2000000000000000000"),
+			want: 0,
+		},
+		{
+			lhs:  nil,
+			rhs:  &BigInt{},
+			want: 0,
+		},
+		{
+			lhs:  MustNewBigInt("-7"),
+			rhs:  MustNewBigInt("7"),
+			want: 0,
+		},
+		{
+			lhs:  MustNewBigInt("-8"),
+			rhs:  MustNewBigInt("7"),
+			want: 1,
+		},
+		{
+			lhs:  MustNewBigInt("7"),
+			rhs:  MustNewBigInt("-8"),
+			want: -1,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := tc.lhs.CmpAbs(tc.rhs); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntEqual(t *testing.T) {
+	tests := []struct {
+		lhs  *BigInt
+		rhs  *BigInt
+		want bool
+	}{
+		{
+			lhs:  MustNewBigInt("300"),
+			rhs:  MustNewBigInt("100").Add(MustNewBigInt("200")),
+			want: true,
+		},
+		{
+			lhs:  MustNewBigInt("1000000000000000000"),
+			rhs:  MustNewBigInt("999999999999999999").Add(NewOne()),
+			want: true,
+		},
+		{
+			lhs:  MustNewBigInt("1"),
+			rhs:  MustNewBigInt("1000000000000000000").Sub(MustNewBigInt("999999999999999999")),
+			want: true,
+		},
+		{
+			lhs:  MustNewBigInt("0"),
+			rhs:  MustNewBigInt("123456789012345678901234567890").Sub(MustNewBigInt("123456789012345678901234567890")),
+			want: true,
+		},
+		{
+			lhs:  MustNewBigInt("999999999999999998000000000000000001"),
+			rhs:  MustNewBigInt("999999999999999999").Mul(MustNewBigInt("999999999999999999")),
+			want: true,
+		},
+		{
+			lhs:  MustNewBigInt("0"),
+			rhs:  MustNewBigInt("123456789012345678901234567890").Mul(NewZero()),
+			want: true,
+		},
+		{
+			// INFO: leading zero chunks and an unset length, built by hand
+			lhs:  &BigInt{magnitude: []uint64{0, 0, 300}},
+			rhs:  MustNewBigInt("300"),
+			want: true,
+		},
+		{
+			// INFO: a chunk that doesn't fit in the chunk size, built by hand
+			lhs:  &BigInt{magnitude: []uint64{0, 1000000000000000300}, chukSize: maxChunkSize},
+			rhs:  MustNewBigInt("1000000000000000300"),
+			want: true,
+		},
+		{
+			lhs:  MustNewBigInt("300", WithChunkSize(1)),
+			rhs:  MustNewBigInt("100").Add(MustNewBigInt("200")),
+			want: true,
+		},
+		{
+			lhs:  MustNewBigInt("301"),
+			rhs:  MustNewBigInt("100").Add(MustNewBigInt("200")),
+			want: false,
+		},
+		{
+			lhs:  nil,
+			rhs:  MustNewBigInt("5").Sub(MustNewBigInt("5")),
+			want: true,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := tc.lhs.Equal(tc.rhs); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+
+			if got := tc.rhs.Equal(tc.lhs); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntCmpString(t *testing.T) {
+	tests := []struct {
+		lhs  *BigInt
+		rhs  string
+		want int
+	}{
+		{
+			lhs:  MustNewBigInt("0"),
+			rhs:  "0",
+			want: 0,
+		},
+		{
+			lhs:  nil,
+			rhs:  "000",
+			want: 0,
+		},
+		{
+			lhs:  MustNewBigInt("123"),
+			rhs:  "0000123",
+			want: 0,
+		},
+		{
+			lhs:  MustNewBigInt("123"),
+			rhs:  " \"123.00\"\n",
+			want: 0,
+		},
+		{
+			lhs:  MustNewBigInt("123"),
+			rhs:  "1234",
+			want: -1,
+		},
+		{
+			lhs:  MustNewBigInt("1234"),
+			rhs:  "123",
+			want: 1,
+		},
+		{
+			lhs:  MustNewBigInt("123456789012345678901234567890"),
+			rhs:  "123456789012345678901234567891",
+			want: -1,
+		},
+		{
+			lhs:  MustNewBigInt("123456789012345678901234567890", WithChunkSize(4)),
+			rhs:  "123456789012345678901234567889",
+			want: 1,
+		},
+		{
+			lhs:  MustNewBigInt("1000000000000000000000000000001"),
+			rhs:  "1000000000000000000000000000001",
+			want: 0,
+		},
+		{
+			// INFO: a chunk that doesn't fit in the chunk size, built by hand
+			lhs:  &BigInt{magnitude: []uint64{0, 1000000000000000300}, chukSize: maxChunkSize},
+			rhs:  "1000000000000000300",
+			want: 0,
+		},
+		{
+			lhs:  MustNewBigInt("-5"),
+			rhs:  "0",
+			want: -1,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := tc.lhs.CmpString(tc.rhs)
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntCmpStringInvalid(t *testing.T) {
+	for idx, value := range []string{"", "abc", "12a3", "1.5", "1.", ".0", "-", "1,000"} {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			_, want := NewBigInt(value)
+			if want == nil {
+				t.Fatalf("NewBigInt(%q) is valid", value)
+			}
+
+			if _, err := MustNewBigInt("123").CmpString(value); err != want {
+				t.Errorf("got %v, want %v", err, want)
+			}
+		})
+	}
+}
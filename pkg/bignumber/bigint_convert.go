@@ -0,0 +1,90 @@
+package bignumber
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+// NewBigIntFromFloat64 creates a new BigInt from the integer part of f, the
+// fraction is truncated toward zero, Ex: 1.5 is 1 and -1.5 is -1. The truncated
+// value is returned with ErrInexact when f has a fraction, so the callers that
+// need an exact integer can reject it. It returns ErrOutOfRange for NaN and ±Inf.
+//
+// INFO: the result is the exact value of the float64, not of the literal it was
+// written from. Above 2^53 the float64 values are spaced more than 1 apart,
+// Ex: 1e23 results in 99999999999999991611392.
+func NewBigIntFromFloat64(f float64) (*BigInt, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, ErrOutOfRange
+	}
+
+	integer := math.Trunc(f)
+
+	// INFO: the integer float64 values are formatted exactly without decimal places
+	bigInt, err := NewBigInt(strconv.FormatFloat(integer, 'f', 0, 64))
+	if err != nil {
+		return nil, err
+	}
+
+	if integer != f {
+		return bigInt, ErrInexact
+	}
+
+	return bigInt, nil
+}
+
+// ToUint64 returns the BigInt as an uint64, it returns ErrOverflow
+// if the value does not fit in an uint64, which includes the negative values.
+func (b *BigInt) ToUint64() (uint64, error) {
+	b = b.orZero()
+
+	if b.negative {
+		return 0, ErrOverflow
+	}
+
+	base := b.base()
+
+	var result uint64
+
+	for _, chunk := range normalizeMagnitude(b.magnitude, base) {
+		// Shift the previous chunks one position to the left
+		hi, lo := bits.Mul64(result, base)
+		if hi != 0 {
+			return 0, ErrOverflow
+		}
+
+		// Append the current chunk
+		var carry uint64
+
+		result, carry = bits.Add64(lo, uint64(chunk), 0)
+		if carry != 0 {
+			return 0, ErrOverflow
+		}
+	}
+
+	return result, nil
+}
+
+// ToFloat64 returns the float64 nearest to the BigInt, it returns ErrInexact
+// if the value cannot be represented exactly, and ±Inf with ErrOverflow
+// if the absolute value is beyond math.MaxFloat64.
+func (b *BigInt) ToFloat64() (float64, error) {
+	b = b.orZero()
+
+	decimal := b.String()
+
+	// INFO: ParseFloat rounds to the nearest float64 and returns ±Inf out of range
+	float, err := strconv.ParseFloat(decimal, 64)
+	if err != nil {
+		return float, ErrOverflow
+	}
+
+	// The float64 values above 2^53 are integers, so formatting them
+	// without decimal places gives their exact decimal representation
+	if strconv.FormatFloat(float, 'f', 0, 64) != decimal {
+		return float, ErrInexact
+	}
+
+	return float, nil
+}
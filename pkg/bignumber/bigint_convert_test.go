@@ -0,0 +1,233 @@
+package bignumber
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestBigIntToUint64(t *testing.T) {
+	tests := []struct {
+		input string
+		want  uint64
+		err   error
+	}{
+		{
+			input: "0",
+			want:  0,
+			err:   nil,
+		},
+		{
+			input: "1000000000",
+			want:  1000000000,
+			err:   nil,
+		},
+		{
+			input: "18446744073709551615",
+			want:  18446744073709551615,
+			err:   nil,
+		},
+		{
+			input: "000000000018446744073709551615",
+			want:  18446744073709551615,
+			err:   nil,
+		},
+		{
+			// INFO: This is one more than the max value of uint64.
+			input: "18446744073709551616",
+			want:  0,
+			err:   ErrOverflow,
+		},
+		{
+			input: "184467440737095516150",
+			want:  0,
+			err:   ErrOverflow,
+		},
+		{
+			input: "-1",
+			want:  0,
+			err:   ErrOverflow,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			bg, _ := NewBigInt(tc.input)
+
+			got, err := bg.ToUint64()
+			if err != tc.err {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntToFloat64(t *testing.T) {
+	tests := []struct {
+		input string
+		want  float64
+		err   error
+	}{
+		{
+			input: "0",
+			want:  0,
+			err:   nil,
+		},
+		{
+			input: "123456789",
+			want:  123456789,
+			err:   nil,
+		},
+		{
+			input: "000000000123456789",
+			want:  123456789,
+			err:   nil,
+		},
+		{
+			// INFO: This is 2^53, the last integer before the gaps appear.
+			input: "9007199254740992",
+			want:  9007199254740992,
+			err:   nil,
+		},
+		{
+			input: "9007199254740993",
+			want:  9007199254740992,
+			err:   ErrInexact,
+		},
+		{
+			input: "1000000000000000000000000000000",
+			want:  1e30,
+			err:   ErrInexact,
+		},
+		{
+			input: "1" + strings.Repeat("0", 400),
+			want:  math.Inf(1),
+			err:   ErrOverflow,
+		},
+		{
+			input: "-9007199254740993",
+			want:  -9007199254740992,
+			err:   ErrInexact,
+		},
+		{
+			input: "-1" + strings.Repeat("0", 400),
+			want:  math.Inf(-1),
+			err:   ErrOverflow,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(tc.input).ToFloat64()
+			if err != tc.err {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewBigIntFromFloat64(t *testing.T) {
+	tests := []struct {
+		input float64
+		want  string
+		err   error
+	}{
+		{
+			input: 0,
+			want:  "0",
+			err:   nil,
+		},
+		{
+			input: math.Copysign(0, -1),
+			want:  "0",
+			err:   nil,
+		},
+		{
+			input: 1e15,
+			want:  "1000000000000000",
+			err:   nil,
+		},
+		{
+			input: 1.5,
+			want:  "1",
+			err:   ErrInexact,
+		},
+		{
+			input: -1.5,
+			want:  "-1",
+			err:   ErrInexact,
+		},
+		{
+			input: -0.25,
+			want:  "0",
+			err:   ErrInexact,
+		},
+		{
+			input: -123456789,
+			want:  "-123456789",
+			err:   nil,
+		},
+		{
+			// INFO: This is 2^53, the last integer before the gaps appear.
+			input: 9007199254740992,
+			want:  "9007199254740992",
+			err:   nil,
+		},
+		{
+			// INFO: 1e23 can't be represented, the float64 is the nearest value
+			input: 1e23,
+			want:  "99999999999999991611392",
+			err:   nil,
+		},
+		{
+			input: -0x1p70,
+			want:  "-1180591620717411303424",
+			err:   nil,
+		},
+		{
+			input: math.MaxFloat64,
+			want:  "179769313486231570814527423731704356798070567525844996598917476803157260780028538760589558632766878171540458953514382464234321326889464182768467546703537516986049910576551282076245490090389328944075868508455133942304583236903222948165808559332123348274797826204144723168738177180919299881250404026184124858368",
+			err:   nil,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := NewBigIntFromFloat64(tc.input)
+			if err != tc.err {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestNewBigIntFromFloat64Invalid(t *testing.T) {
+	for idx, input := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got, err := NewBigIntFromFloat64(input); err != ErrOutOfRange || got != nil {
+				t.Errorf("got %v, %v, want nil, %v", got, err, ErrOutOfRange)
+			}
+		})
+	}
+}
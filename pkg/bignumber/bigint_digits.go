@@ -0,0 +1,199 @@
+package bignumber
+
+import "iter"
+
+// NumTrailingZeros returns the number of trailing zero decimal digits
+// of the BigInt, Ex: 1200 returns 2. Zero has no trailing zeros, it returns 0.
+func (b *BigInt) NumTrailingZeros() int {
+	b = b.orZero()
+
+	magnitude := normalizeMagnitude(b.magnitude, b.base())
+
+	if isZeroMagnitude(magnitude) {
+		return 0
+	}
+
+	var count int
+
+	// Scan from the least significant chunk, every zero chunk is a run of chunkSize zeros
+	for idx := len(magnitude) - 1; idx >= 0; idx-- {
+		chunk := magnitude[idx]
+
+		if chunk == 0 {
+			count += b.chunkSize()
+
+			continue
+		}
+
+		for chunk%10 == 0 {
+			chunk /= 10
+			count++
+		}
+
+		break
+	}
+
+	return count
+}
+
+// IsPowerOfTen reports whether the BigInt is a power of ten,
+// Ex: 1, 10, 100, etc. Zero is not a power of ten.
+func (b *BigInt) IsPowerOfTen() bool {
+	b = b.orZero()
+
+	magnitude := normalizeMagnitude(b.magnitude, b.base())
+
+	// INFO: a power of ten is a single 1 followed by zeros, so the leading
+	// chunk must be a power of ten and the rest of the chunks must be zero
+	if len(magnitude) > 1 && !isZeroMagnitude(magnitude[1:]) {
+		return false
+	}
+
+	for _, power := range powersOfTen[:b.chunkSize()] {
+		if magnitude[0] == power {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Digits returns an iterator over the decimal digits of the BigInt,
+// from the most significant to the least significant one. Zero yields a single 0.
+func (b *BigInt) Digits() iter.Seq[int] {
+	b = b.orZero()
+
+	return func(yield func(int) bool) {
+		magnitude := normalizeMagnitude(b.magnitude, b.base())
+
+		for idx, chunk := range magnitude {
+			// INFO: the leading chunk is not padded, the rest have chunkSize digits
+			size := b.chunkSize()
+			if idx == 0 {
+				size = chunkDigits(chunk)
+			}
+
+			for power := size - 1; power >= 0; power-- {
+				if !yield(int(chunk / powersOfTen[power] % 10)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// DigitsReversed is like Digits but the digits go from
+// the least significant to the most significant one.
+func (b *BigInt) DigitsReversed() iter.Seq[int] {
+	b = b.orZero()
+
+	return func(yield func(int) bool) {
+		magnitude := normalizeMagnitude(b.magnitude, b.base())
+
+		for idx := len(magnitude) - 1; idx >= 0; idx-- {
+			chunk := magnitude[idx]
+
+			size := b.chunkSize()
+			if idx == 0 {
+				size = chunkDigits(chunk)
+			}
+
+			for range size {
+				if !yield(int(chunk % 10)) {
+					return
+				}
+
+				chunk /= 10
+			}
+		}
+	}
+}
+
+// MaxDigit returns the largest decimal digit of the BigInt, the zeros
+// inside the number count as digits. The largest digit of 0 is 0.
+func (b *BigInt) MaxDigit() int {
+	var result int
+
+	for digit := range b.Digits() {
+		result = max(result, digit)
+
+		// INFO: no digit is larger than 9, so there is no need to keep going
+		if result == 9 {
+			break
+		}
+	}
+
+	return result
+}
+
+// MinDigit returns the smallest decimal digit of the BigInt, the zeros
+// inside the number count as digits. The smallest digit of 0 is 0.
+func (b *BigInt) MinDigit() int {
+	result := 9
+
+	for digit := range b.Digits() {
+		result = min(result, digit)
+
+		// INFO: no digit is smaller than 0, so there is no need to keep going
+		if result == 0 {
+			break
+		}
+	}
+
+	return result
+}
+
+// DigitHistogram returns how many times every decimal digit appears in the
+// BigInt, indexed by the digit, Ex: 1000 returns 3 zeros and 1 one. The zeros
+// inside the number are counted, so the counts add up to Length().
+func (b *BigInt) DigitHistogram() [10]int {
+	var histogram [10]int
+
+	for digit := range b.Digits() {
+		histogram[digit]++
+	}
+
+	return histogram
+}
+
+// SumOfDigits returns the sum of the decimal digits of the BigInt,
+// Ex: 1234 returns 10. The sum of the digits of 0 is 0.
+func (b *BigInt) SumOfDigits() uint64 {
+	b = b.orZero()
+
+	var sum uint64
+
+	// INFO: the padding zeros of the chunks don't change the sum,
+	// so every chunk is summed the same way
+	for _, chunk := range normalizeMagnitude(b.magnitude, b.base()) {
+		for ; chunk != 0; chunk /= 10 {
+			sum += uint64(chunk % 10)
+		}
+	}
+
+	return sum
+}
+
+// DigitalRoot returns the single digit obtained by repeatedly summing the
+// decimal digits of the BigInt, Ex: 9875 returns 2. The digital root of 0 is 0.
+func (b *BigInt) DigitalRoot() int {
+	sum := b.SumOfDigits()
+	if sum == 0 {
+		return 0
+	}
+
+	// INFO: a number and its digit sum are congruent modulo 9,
+	// so the repeated sums end in the value modulo 9, or 9 itself
+	return int(1 + (sum-1)%9)
+}
+
+// SuperDigit returns the digital root of the BigInt concatenated with itself
+// repeat times, Ex: 9875 repeated 4 times returns 8. The number is never
+// built, the digit sum is multiplied by repeat before being reduced.
+// The super digit of zero copies is 0.
+func (b *BigInt) SuperDigit(repeat uint) int {
+	base := powersOfTen[maxChunkSize]
+	sum := newBigIntFromMagnitude(magnitudeFromUint64(b.SumOfDigits(), base), maxChunkSize)
+
+	return sum.MulScalar(uint64(repeat)).DigitalRoot()
+}
@@ -0,0 +1,416 @@
+package bignumber
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBigIntNumTrailingZeros(t *testing.T) {
+	tests := []struct {
+		value *BigInt
+		want  int
+	}{
+		{
+			value: MustNewBigInt("0"),
+			want:  0,
+		},
+		{
+			value: MustNewBigInt("7"),
+			want:  0,
+		},
+		{
+			value: MustNewBigInt("1200"),
+			want:  2,
+		},
+		{
+			value: MustNewBigInt("1000000000"),
+			want:  9,
+		},
+		{
+			value: MustNewBigInt("1010000000000000000000"),
+			want:  19,
+		},
+		{
+			value: MustNewBigInt("10").Pow(100),
+			want:  100,
+		},
+		{
+			// INFO: 100! has 24 trailing zeros, one per factor of 5 and 25
+			value: factorial(100),
+			want:  24,
+		},
+		{
+			value: factorial(1000),
+			want:  249,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := tc.value.NumTrailingZeros(); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// factorial returns n! for the tests.
+func factorial(n uint64) *BigInt {
+	result := NewOne()
+
+	for i := uint64(2); i <= n; i++ {
+		result = result.MulScalar(i)
+	}
+
+	return result
+}
+
+func TestBigIntIsPowerOfTen(t *testing.T) {
+	tests := []struct {
+		value *BigInt
+		want  bool
+	}{
+		{
+			value: MustNewBigInt("0"),
+			want:  false,
+		},
+		{
+			value: MustNewBigInt("1"),
+			want:  true,
+		},
+		{
+			value: MustNewBigInt("10"),
+			want:  true,
+		},
+		{
+			value: MustNewBigInt("100000000"),
+			want:  true,
+		},
+		{
+			value: MustNewBigInt("1000000000"),
+			want:  true,
+		},
+		{
+			value: MustNewBigInt("0001000000000000000000"),
+			want:  true,
+		},
+		{
+			value: MustNewBigInt("10").Pow(100),
+			want:  true,
+		},
+		{
+			value: MustNewBigInt("20"),
+			want:  false,
+		},
+		{
+			value: MustNewBigInt("101"),
+			want:  false,
+		},
+		{
+			value: MustNewBigInt("1000000001"),
+			want:  false,
+		},
+		{
+			value: MustNewBigInt("1100000000"),
+			want:  false,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := tc.value.IsPowerOfTen(); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntDigits(t *testing.T) {
+	tests := []*BigInt{
+		MustNewBigInt("0"),
+		MustNewBigInt("7"),
+		MustNewBigInt("1000000000"),
+		MustNewBigInt("1000000001000000000"),
+		MustNewBigInt("000000000000000000123"),
+		MustNewBigInt("123456789012345678901234567890"),
+		{},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			var digits, reversed []byte
+
+			for digit := range tc.Digits() {
+				digits = append(digits, byte('0'+digit))
+			}
+
+			for digit := range tc.DigitsReversed() {
+				reversed = append(reversed, byte('0'+digit))
+			}
+
+			slices.Reverse(reversed)
+
+			if got, want := string(digits), tc.String(); got != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+
+			if got, want := string(reversed), tc.String(); got != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBigIntDigitsBreak(t *testing.T) {
+	var digits []int
+
+	for digit := range MustNewBigInt("123456789012345678901234567890").Digits() {
+		if len(digits) == 3 {
+			break
+		}
+
+		digits = append(digits, digit)
+	}
+
+	if want := []int{1, 2, 3}; !slices.Equal(digits, want) {
+		t.Errorf("got %v, want %v", digits, want)
+	}
+}
+
+func TestBigIntMaxAndMinDigit(t *testing.T) {
+	tests := []struct {
+		value string
+		max   int
+		min   int
+	}{
+		{
+			value: "0",
+			max:   0,
+			min:   0,
+		},
+		{
+			value: "5",
+			max:   5,
+			min:   5,
+		},
+		{
+			value: "1050607",
+			max:   7,
+			min:   0,
+		},
+		{
+			value: "1000000001",
+			max:   1,
+			min:   0,
+		},
+		{
+			value: "000000000000000000345",
+			max:   5,
+			min:   3,
+		},
+		{
+			value: "123456789123456789",
+			max:   9,
+			min:   1,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value := MustNewBigInt(tc.value)
+
+			if got := value.MaxDigit(); got != tc.max {
+				t.Errorf("got %v, want %v", got, tc.max)
+			}
+
+			if got := value.MinDigit(); got != tc.min {
+				t.Errorf("got %v, want %v", got, tc.min)
+			}
+		})
+	}
+}
+
+func TestBigIntSumOfDigits(t *testing.T) {
+	tests := []struct {
+		value string
+		sum   uint64
+		root  int
+	}{
+		{
+			value: "0",
+			sum:   0,
+			root:  0,
+		},
+		{
+			value: "7",
+			sum:   7,
+			root:  7,
+		},
+		{
+			value: "9875",
+			sum:   29,
+			root:  2,
+		},
+		{
+			value: "1000000001",
+			sum:   2,
+			root:  2,
+		},
+		{
+			value: "999999999999999999",
+			sum:   162,
+			root:  9,
+		},
+		{
+			value: "000000000000000000345",
+			sum:   12,
+			root:  3,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value := MustNewBigInt(tc.value)
+
+			if got := value.SumOfDigits(); got != tc.sum {
+				t.Errorf("got %v, want %v", got, tc.sum)
+			}
+
+			if got := value.DigitalRoot(); got != tc.root {
+				t.Errorf("got %v, want %v", got, tc.root)
+			}
+		})
+	}
+}
+
+func TestBigIntDigitHistogram(t *testing.T) {
+	tests := []struct {
+		value     *BigInt
+		histogram [10]int
+	}{
+		{
+			value:     MustNewBigInt("0"),
+			histogram: [10]int{1},
+		},
+		{
+			value:     nil,
+			histogram: [10]int{1},
+		},
+		{
+			value:     MustNewBigInt("1234567890"),
+			histogram: [10]int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		{
+			value:     MustNewBigInt("1000000000000000000000000000000000001"),
+			histogram: [10]int{35, 2},
+		},
+		{
+			value:     MustNewBigInt("000000000000000000345"),
+			histogram: [10]int{0, 0, 0, 1, 1, 1},
+		},
+		{
+			value:     MustNewBigInt("100200300", WithChunkSize(3)),
+			histogram: [10]int{6, 1, 1, 1},
+		},
+		{
+			value:     MustNewBigInt("999999999999999999").Add(MustNewBigInt("1")),
+			histogram: [10]int{18, 1},
+		},
+		{
+			value:     MustNewBigInt("-7007"),
+			histogram: [10]int{2, 0, 0, 0, 0, 0, 0, 2},
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := tc.value.DigitHistogram()
+			if got != tc.histogram {
+				t.Errorf("got %v, want %v", got, tc.histogram)
+			}
+
+			var total int
+			for _, count := range got {
+				total += count
+			}
+
+			if total != tc.value.Length() {
+				t.Errorf("got %v digits, want %v", total, tc.value.Length())
+			}
+		})
+	}
+}
+
+func TestBigIntSuperDigit(t *testing.T) {
+	tests := []struct {
+		value  string
+		repeat uint
+		want   int
+	}{
+		{
+			value:  "9875",
+			repeat: 4,
+			want:   8,
+		},
+		{
+			value:  "148",
+			repeat: 3,
+			want:   3,
+		},
+		{
+			value:  "123",
+			repeat: 3,
+			want:   9,
+		},
+		{
+			value:  "0",
+			repeat: 10,
+			want:   0,
+		},
+		{
+			value:  "9875",
+			repeat: 0,
+			want:   0,
+		},
+		{
+			value:  "861568688536788",
+			repeat: 100000,
+			want:   3,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value := MustNewBigInt(tc.value)
+
+			if got := value.SuperDigit(tc.repeat); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+
+			// INFO: the short repeats are checked against the concatenated number
+			if tc.repeat > 0 && tc.repeat < 10 {
+				concatenated := MustNewBigInt(strings.Repeat(tc.value, int(tc.repeat)))
+
+				if got := concatenated.DigitalRoot(); got != tc.want {
+					t.Errorf("got %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package bignumber
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+const (
+	// groupSize is the number of digits between the separators of the grouped format.
+	groupSize = 3
+	// defaultGroupSeparator is the separator used when no separator is given.
+	defaultGroupSeparator = ','
+)
+
+// groupSeparator returns sep, or the default separator when sep is 0.
+func groupSeparator(sep rune) rune {
+	if sep == 0 {
+		return defaultGroupSeparator
+	}
+
+	return sep
+}
+
+// FormatGrouped returns the decimal representation of the BigInt with
+// the digits grouped by thousands using sep, Ex: 1234567 is 1,234,567.
+// A sep of 0 uses the default separator ','. The sign of the negative
+// values goes before the groups, Ex: -1,234.
+func (b *BigInt) FormatGrouped(sep rune) string {
+	sep = groupSeparator(sep)
+
+	digits := b.ToDecimalBytes()
+
+	result := make([]byte, 0, len(digits)+len(digits)/groupSize*utf8.RuneLen(sep))
+
+	// The sign is not part of the groups
+	if digits[0] == '-' {
+		result, digits = append(result, '-'), digits[1:]
+	}
+
+	for idx, digit := range digits {
+		if idx > 0 && (len(digits)-idx)%groupSize == 0 {
+			result = utf8.AppendRune(result, sep)
+		}
+
+		result = append(result, digit)
+	}
+
+	return string(result)
+}
+
+// WriteGrouped writes the same output as FormatGrouped to w, the digits
+// are streamed through a small buffer so the whole representation is never
+// built in memory. It returns the number of bytes written.
+func (b *BigInt) WriteGrouped(w io.Writer, sep rune) (int64, error) {
+	sep = groupSeparator(sep)
+
+	var (
+		buffer  [512]byte
+		pending = buffer[:0]
+		written int64
+	)
+
+	// flush writes the pending bytes to w
+	flush := func() error {
+		n, err := w.Write(pending)
+		written += int64(n)
+		pending = pending[:0]
+
+		return err
+	}
+
+	if b.orZero().negative {
+		pending = append(pending, '-')
+	}
+
+	length := b.Length()
+	idx := 0
+
+	for digit := range b.Digits() {
+		if idx > 0 && (length-idx)%groupSize == 0 {
+			pending = utf8.AppendRune(pending, sep)
+		}
+
+		pending = append(pending, byte('0'+digit))
+		idx++
+
+		// INFO: keep room for a digit and the widest separator
+		if len(pending) > len(buffer)-utf8.UTFMax-1 {
+			if err := flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, flush()
+}
@@ -0,0 +1,173 @@
+package bignumber
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestBigIntFormatGrouped(t *testing.T) {
+	tests := []struct {
+		value string
+		sep   rune
+		want  string
+	}{
+		{
+			value: "0",
+			sep:   ',',
+			want:  "0",
+		},
+		{
+			value: "123",
+			sep:   ',',
+			want:  "123",
+		},
+		{
+			value: "1234",
+			sep:   ',',
+			want:  "1,234",
+		},
+		{
+			value: "1234567",
+			sep:   '.',
+			want:  "1.234.567",
+		},
+		{
+			value: "1000000000",
+			sep:   ',',
+			want:  "1,000,000,000",
+		},
+		{
+			value: "123456789012345678901234567890",
+			sep:   ' ',
+			want:  "123 456 789 012 345 678 901 234 567 890",
+		},
+		{
+			value: "1000001",
+			sep:   ' ',
+			want:  "1 000 001",
+		},
+		{
+			value: "-123",
+			sep:   ',',
+			want:  "-123",
+		},
+		{
+			value: "-1234567",
+			sep:   ',',
+			want:  "-1,234,567",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := MustNewBigInt(tc.value).FormatGrouped(tc.sep); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntWriteGrouped(t *testing.T) {
+	tests := []struct {
+		value string
+		sep   rune
+	}{
+		{
+			value: "0",
+			sep:   ',',
+		},
+		{
+			value: "12345",
+			sep:   ',',
+		},
+		{
+			value: "1000000000000000000",
+			sep:   '.',
+		},
+		{
+			value: strings.Repeat("1234567890", 100),
+			sep:   ',',
+		},
+		{
+			value: strings.Repeat("9", 2000),
+			sep:   ' ',
+		},
+		{
+			value: "-" + strings.Repeat("1234567890", 100),
+			sep:   ',',
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value := MustNewBigInt(tc.value)
+			want := value.FormatGrouped(tc.sep)
+
+			var builder strings.Builder
+
+			n, err := value.WriteGrouped(&builder, tc.sep)
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if builder.String() != want {
+				t.Errorf("got %v, want %v", builder.String(), want)
+			}
+
+			if n != int64(len(want)) {
+				t.Errorf("got %v, want %v", n, len(want))
+			}
+		})
+	}
+}
+
+// failingWriter is an io.Writer that fails after accepting limit bytes.
+type failingWriter struct {
+	limit int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		n := w.limit
+		w.limit = 0
+
+		return n, errors.New("write failed")
+	}
+
+	w.limit -= len(p)
+
+	return len(p), nil
+}
+
+func TestBigIntWriteGroupedError(t *testing.T) {
+	value := MustNewBigInt(strings.Repeat("1234567890", 100))
+
+	n, err := value.WriteGrouped(&failingWriter{limit: 100}, ',')
+	if err == nil {
+		t.Errorf("got nil, want an error")
+	}
+
+	if n != 100 {
+		t.Errorf("got %v, want %v", n, 100)
+	}
+}
+
+func TestBigIntFormatGroupedDefaultSeparator(t *testing.T) {
+	value := MustNewBigInt("1234567")
+
+	if got := value.FormatGrouped(0); got != "1,234,567" {
+		t.Errorf("got %v, want %v", got, "1,234,567")
+	}
+
+	var builder strings.Builder
+
+	if _, err := value.WriteGrouped(&builder, 0); err != nil || builder.String() != "1,234,567" {
+		t.Errorf("got %v, want %v", builder.String(), "1,234,567")
+	}
+}
@@ -0,0 +1,26 @@
+package bignumber
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// NewBigIntFromJSONNumber creates a new BigInt from a json.Number
+// The number must be a plain integer, any value with a decimal point
+// or an exponent is rejected, even if it is integer-valued (Ex: 1e3)
+//
+// Ex: json.Number("123"), json.Number("123456789012345678901234567890"), etc.
+func NewBigIntFromJSONNumber(number json.Number) (*BigInt, error) {
+	value := number.String()
+
+	if strings.ContainsAny(value, ".eE") {
+		return nil, ErrInvalidIntegerNumber
+	}
+
+	return NewBigInt(value)
+}
+
+// JSONNumber returns the json.Number representation of the BigInt.
+func (b *BigInt) JSONNumber() json.Number {
+	return json.Number(b.String())
+}
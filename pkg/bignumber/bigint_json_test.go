@@ -0,0 +1,86 @@
+package bignumber
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestNewBigIntFromJSONNumber(t *testing.T) {
+	tests := []struct {
+		input json.Number
+		want  string
+		err   error
+	}{
+		{
+			input: "123",
+			want:  "123",
+			err:   nil,
+		},
+		{
+			input: "123456789012345678901234567890",
+			want:  "123456789012345678901234567890",
+			err:   nil,
+		},
+		{
+			input: "-123456789012345678901234567890",
+			want:  "-123456789012345678901234567890",
+			err:   nil,
+		},
+		{
+			input: "1.5",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1e3",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1E3",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			bg, err := NewBigIntFromJSONNumber(tc.input)
+			if err != tc.err {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+
+			if bg != nil && bg.String() != tc.want {
+				t.Errorf("got %v, want %v", bg.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntJSONNumberRoundTrip(t *testing.T) {
+	input := `{"value": 123456789012345678901234567890}`
+
+	decoder := json.NewDecoder(strings.NewReader(input))
+	decoder.UseNumber()
+
+	var payload struct {
+		Value json.Number `json:"value"`
+	}
+
+	if err := decoder.Decode(&payload); err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+
+	bg, err := NewBigIntFromJSONNumber(payload.Value)
+	if err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+
+	if bg.JSONNumber() != payload.Value {
+		t.Errorf("got %v, want %v", bg.JSONNumber(), payload.Value)
+	}
+}
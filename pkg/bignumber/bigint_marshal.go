@@ -0,0 +1,149 @@
+package bignumber
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// chunkBytes is the number of bytes used to encode a chunk in the binary format.
+const chunkBytes = 4
+
+// binaryChunkBase is the base of the chunks in the binary format.
+//
+// INFO: the format predates the uint64 chunks, it keeps the 9 digits
+// uint32 chunks so the values encoded before can still be decoded.
+const binaryChunkBase = 1000000000
+
+// The binary format starts with a version tag, the high bit of the tag is
+// set and the rest of the bits hold the version of the layout that follows.
+//
+// INFO: the format had no tag before, those values start with a chunk lower
+// than 10^9 < 2^30, so their first byte never has the high bit set and they
+// are still decoded as version 1 chunks.
+const (
+	// binaryVersionFlag marks the first byte as a version tag.
+	binaryVersionFlag = 0x80
+	// binaryUnsignedVersion is the version with the chunks right after the tag.
+	binaryUnsignedVersion = 1
+	// binaryVersion is the version written by AppendBinary, it adds a sign
+	// byte between the tag and the chunks.
+	binaryVersion = 2
+)
+
+// AppendText implements the encoding.TextAppender interface
+// appending the decimal representation of the BigInt to dst.
+func (b *BigInt) AppendText(dst []byte) ([]byte, error) {
+	return b.appendDecimal(dst), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (b *BigInt) MarshalText() ([]byte, error) {
+	b = b.orZero()
+
+	return b.AppendText(make([]byte, 0, len(b.magnitude)*b.chunkSize()))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (b *BigInt) UnmarshalText(text []byte) error {
+	bigInt, err := NewBigInt(string(text))
+	if err != nil {
+		return err
+	}
+
+	return b.set(bigInt)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface
+// appending the binary representation of the BigInt to dst.
+//
+// The binary representation is laid out as:
+//
+//	byte 0     the version tag, 0x80 | 2 for the current version
+//	byte 1     the sign, 1 for the negative values and 0 otherwise
+//	bytes 2..  the 9 digits chunks of the absolute value, from the most
+//	           significant to the least significant, encoded as big-endian uint32
+//
+// Version 1 has the same layout without the sign byte.
+func (b *BigInt) AppendBinary(dst []byte) ([]byte, error) {
+	// INFO: the binary chunks are the halves of the chunks of maxChunkSize digits
+	b = b.inChunksOf(maxChunkSize)
+
+	value := b.signed()
+
+	sign := byte(0)
+	if value.negative {
+		sign = 1
+	}
+
+	dst = append(dst, binaryVersionFlag|binaryVersion, sign)
+	start := len(dst)
+
+	// Every chunk is split in two binary chunks, the leading zero one is skipped
+	for _, chunk := range value.magnitude {
+		if hi := uint32(chunk / binaryChunkBase); hi != 0 || len(dst) > start {
+			dst = binary.BigEndian.AppendUint32(dst, hi)
+		}
+
+		dst = binary.BigEndian.AppendUint32(dst, uint32(chunk%binaryChunkBase))
+	}
+
+	return dst, nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (b *BigInt) MarshalBinary() ([]byte, error) {
+	b = b.orZero()
+
+	return b.AppendBinary(make([]byte, 0, 2+2*len(b.magnitude)*chunkBytes))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface, it
+// decodes the layout of AppendBinary, the version 1 layout and the untagged
+// chunks written before the version tag. It returns an error wrapping
+// ErrUnsupportedBinaryVersion when the data was written by a newer version
+// of the format.
+func (b *BigInt) UnmarshalBinary(data []byte) error {
+	negative := false
+
+	if len(data) > 0 && data[0]&binaryVersionFlag != 0 {
+		switch version := data[0] &^ binaryVersionFlag; version {
+		case binaryUnsignedVersion:
+			data = data[1:]
+		case binaryVersion:
+			if len(data) < 2 || data[1] > 1 {
+				return ErrInvalidBinaryEncoding
+			}
+
+			negative, data = data[1] == 1, data[2:]
+		default:
+			return fmt.Errorf("decoding binary version %d: %w", version, ErrUnsupportedBinaryVersion)
+		}
+	}
+
+	if len(data) == 0 || len(data)%chunkBytes != 0 {
+		return ErrInvalidBinaryEncoding
+	}
+
+	count := len(data) / chunkBytes
+	magnitude := make([]uint64, (count+1)/2)
+
+	// Pair the binary chunks from the least significant one,
+	// the most significant chunk may be left alone
+	for idx := range count {
+		chunk := binary.BigEndian.Uint32(data[(count-idx-1)*chunkBytes:])
+
+		// Every chunk must fit in the chunk size
+		if chunk >= binaryChunkBase {
+			return ErrInvalidBinaryEncoding
+		}
+
+		value := uint64(chunk)
+		if idx%2 == 1 {
+			value *= binaryChunkBase
+		}
+
+		magnitude[len(magnitude)-idx/2-1] += value
+	}
+
+	return b.set(newBigIntFromSigned(signedMagnitude{magnitude, negative}, maxChunkSize))
+}
@@ -0,0 +1,374 @@
+package bignumber
+
+import (
+	"bytes"
+	"encoding"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+var (
+	_ encoding.TextAppender      = &BigInt{}
+	_ encoding.TextMarshaler     = &BigInt{}
+	_ encoding.TextUnmarshaler   = &BigInt{}
+	_ encoding.BinaryAppender    = &BigInt{}
+	_ encoding.BinaryMarshaler   = &BigInt{}
+	_ encoding.BinaryUnmarshaler = &BigInt{}
+)
+
+var marshalInputs = []string{
+	"0",
+	"1",
+	"1000000000",
+	"1000000000000000001",
+	"123456789012345678901234567890",
+	"-1",
+	"-123456789012345678901234567890",
+}
+
+func TestBigIntTextRoundTrip(t *testing.T) {
+	for idx, input := range marshalInputs {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			text, err := MustNewBigInt(input).MarshalText()
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if string(text) != input {
+				t.Errorf("got %v, want %v", string(text), input)
+			}
+
+			var got BigInt
+			if err := got.UnmarshalText(text); err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != input {
+				t.Errorf("got %v, want %v", got.String(), input)
+			}
+		})
+	}
+}
+
+func TestBigIntBinaryRoundTrip(t *testing.T) {
+	for idx, input := range marshalInputs {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			data, err := MustNewBigInt(input).MarshalBinary()
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			var got BigInt
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != input {
+				t.Errorf("got %v, want %v", got.String(), input)
+			}
+		})
+	}
+}
+
+func TestBigIntBinaryRoundTripSpecialValues(t *testing.T) {
+	tests := []struct {
+		input *BigInt
+		want  string
+	}{
+		{
+			// INFO: the zero value has no chunks at all
+			input: &BigInt{},
+			want:  "0",
+		},
+		{
+			input: MustNewBigInt("999999999").Add(NewOne()),
+			want:  "1000000000",
+		},
+		{
+			// INFO: a chunk that doesn't fit in the chunk size, built by hand
+			input: &BigInt{magnitude: []uint64{1999999999999999998}, length: 19, chukSize: maxChunkSize},
+			want:  "1999999999999999998",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			data, err := tc.input.MarshalBinary()
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			var got BigInt
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntUnmarshalBinaryErrors(t *testing.T) {
+	tests := []struct {
+		input []byte
+		err   error
+	}{
+		{
+			input: nil,
+			err:   ErrInvalidBinaryEncoding,
+		},
+		{
+			input: []byte{0, 0, 1},
+			err:   ErrInvalidBinaryEncoding,
+		},
+		{
+			// INFO: 0xffffffff doesn't fit in a 9 digits chunk.
+			input: []byte{0x81, 0xff, 0xff, 0xff, 0xff},
+			err:   ErrInvalidBinaryEncoding,
+		},
+		{
+			// INFO: 10^9 doesn't fit in a 9 digits chunk of the untagged format.
+			input: []byte{0x3b, 0x9a, 0xca, 0x00},
+			err:   ErrInvalidBinaryEncoding,
+		},
+		{
+			input: []byte{0x81},
+			err:   ErrInvalidBinaryEncoding,
+		},
+		{
+			input: []byte{0x81, 0, 0},
+			err:   ErrInvalidBinaryEncoding,
+		},
+		{
+			input: []byte{0x82},
+			err:   ErrInvalidBinaryEncoding,
+		},
+		{
+			input: []byte{0x82, 0},
+			err:   ErrInvalidBinaryEncoding,
+		},
+		{
+			// INFO: the sign byte is either 0 or 1
+			input: []byte{0x82, 2, 0, 0, 0, 1},
+			err:   ErrInvalidBinaryEncoding,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			var got BigInt
+
+			if err := got.UnmarshalBinary(tc.input); err != tc.err {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestBigIntBinaryLayout(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []byte
+	}{
+		{
+			input: "0",
+			want:  []byte{0x82, 0, 0, 0, 0, 0},
+		},
+		{
+			input: "-0",
+			want:  []byte{0x82, 0, 0, 0, 0, 0},
+		},
+		{
+			input: "1",
+			want:  []byte{0x82, 0, 0, 0, 0, 1},
+		},
+		{
+			input: "-1",
+			want:  []byte{0x82, 1, 0, 0, 0, 1},
+		},
+		{
+			input: "1000000000",
+			want:  []byte{0x82, 0, 0, 0, 0, 1, 0, 0, 0, 0},
+		},
+		{
+			input: "1000000000000000000",
+			want:  []byte{0x82, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(tc.input).MarshalBinary()
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if !bytes.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntUnmarshalBinaryUntagged(t *testing.T) {
+	tests := []struct {
+		input []byte
+		want  string
+	}{
+		{
+			input: []byte{0, 0, 0, 0},
+			want:  "0",
+		},
+		{
+			input: []byte{0, 0, 0, 1, 0, 0, 0, 0},
+			want:  "1000000000",
+		},
+		{
+			// INFO: the largest first byte of the untagged chunks, 999999999 is 0x3b9ac9ff
+			input: []byte{0x3b, 0x9a, 0xc9, 0xff, 0x3b, 0x9a, 0xc9, 0xff},
+			want:  "999999999999999999",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			var got BigInt
+			if err := got.UnmarshalBinary(tc.input); err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntUnmarshalBinaryVersions(t *testing.T) {
+	tests := []struct {
+		input []byte
+		want  string
+	}{
+		{
+			input: []byte{0x81, 0, 0, 0, 1, 0, 0, 0, 0},
+			want:  "1000000000",
+		},
+		{
+			input: []byte{0x82, 0, 0, 0, 0, 1, 0, 0, 0, 0},
+			want:  "1000000000",
+		},
+		{
+			input: []byte{0x82, 1, 0, 0, 0, 1, 0, 0, 0, 0},
+			want:  "-1000000000",
+		},
+		{
+			// INFO: the sign of zero is dropped
+			input: []byte{0x82, 1, 0, 0, 0, 0},
+			want:  "0",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			var got BigInt
+			if err := got.UnmarshalBinary(tc.input); err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntUnmarshalBinaryUnknownVersion(t *testing.T) {
+	for idx, input := range [][]byte{{0x83, 0, 0, 0, 0, 1}, {0x80, 0, 0, 0, 1}, {0xff}} {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := MustNewBigInt("123")
+
+			if err := got.UnmarshalBinary(input); !errors.Is(err, ErrUnsupportedBinaryVersion) {
+				t.Errorf("got %v, want %v", err, ErrUnsupportedBinaryVersion)
+			}
+
+			// The receiver is left unchanged
+			if got.String() != "123" {
+				t.Errorf("got %v, want %v", got.String(), "123")
+			}
+		})
+	}
+}
+
+func TestBigIntAppendText(t *testing.T) {
+	buffer := []byte("values:")
+
+	for _, input := range marshalInputs {
+		buffer = append(buffer, ' ')
+		buffer, _ = MustNewBigInt(input).AppendText(buffer)
+	}
+
+	want := "values: 0 1 1000000000 1000000000000000001 123456789012345678901234567890 -1 -123456789012345678901234567890"
+	if string(buffer) != want {
+		t.Errorf("got %v, want %v", string(buffer), want)
+	}
+}
+
+func BenchmarkBigIntAppendText(b *testing.B) {
+	values := make([]*BigInt, 1000)
+	for idx := range values {
+		values[idx] = MustNewBigInt(fmt.Sprintf("%d123456789012345678901234567890", idx))
+	}
+
+	buffer := make([]byte, 0, 64*len(values))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		buffer = buffer[:0]
+
+		for _, value := range values {
+			buffer, _ = value.AppendText(buffer)
+		}
+	}
+}
+
+func BenchmarkBigIntMarshalText(b *testing.B) {
+	values := make([]*BigInt, 1000)
+	for idx := range values {
+		values[idx] = MustNewBigInt(fmt.Sprintf("%d123456789012345678901234567890", idx))
+	}
+
+	buffer := make([]byte, 0, 64*len(values))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		buffer = buffer[:0]
+
+		for _, value := range values {
+			text, _ := value.MarshalText()
+			buffer = append(buffer, text...)
+		}
+	}
+}
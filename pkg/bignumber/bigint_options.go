@@ -0,0 +1,99 @@
+package bignumber
+
+import "strings"
+
+// Option configures how NewBigInt parses its value.
+type Option func(*parseOptions)
+
+// parseOptions holds the settings applied by the options of NewBigInt.
+type parseOptions struct {
+	// chunkSize is the number of digits stored in each chunk
+	chunkSize int
+	// separators are the characters stripped from the value
+	separators []rune
+	// allowSign accepts a leading sign in the value
+	allowSign bool
+}
+
+// WithChunkSize stores the BigInt in chunks of n digits instead of the
+// default maxChunkSize. NewBigInt returns ErrOutOfRange when n is not
+// between 1 and maxChunkSize.
+func WithChunkSize(n int) Option {
+	return func(options *parseOptions) {
+		options.chunkSize = n
+	}
+}
+
+// WithSeparators strips every one of the separators from the value before
+// parsing it, Ex: 1_000_000 with '_'. Unlike NewBigIntFromGrouped the
+// separators can be anywhere between the digits. NewBigInt returns
+// ErrInvalidSeparator when a separator can't be told apart from the number.
+func WithSeparators(separators ...rune) Option {
+	return func(options *parseOptions) {
+		options.separators = append(options.separators, separators...)
+	}
+}
+
+// AllowSign accepts a leading '+' sign in the value, the '-' sign is
+// always accepted. Unlike the sign NewBigInt accepts on its own, the sign
+// may go before the surrounding quotes, Ex: +"123" or -"123".
+func AllowSign() Option {
+	return func(options *parseOptions) {
+		options.allowSign = true
+	}
+}
+
+// newBigIntWithOptions creates a new BigInt from a string applying the options.
+func newBigIntWithOptions(value string, opts []Option) (*BigInt, error) {
+	options := parseOptions{chunkSize: maxChunkSize}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.chunkSize < 1 || options.chunkSize > maxChunkSize {
+		return nil, ErrOutOfRange
+	}
+
+	if len(options.separators) > 0 {
+		for _, sep := range options.separators {
+			if isDigit(sep) || strings.ContainsRune("+-.\"", sep) {
+				return nil, ErrInvalidSeparator
+			}
+		}
+
+		value = strings.Map(func(r rune) rune {
+			for _, sep := range options.separators {
+				if r == sep {
+					return -1
+				}
+			}
+
+			return r
+		}, value)
+	}
+
+	var negative, stripped bool
+
+	if options.allowSign {
+		value = strings.Trim(value, asciiSpace)
+
+		switch {
+		case strings.HasPrefix(value, "+"):
+			value, stripped = value[1:], true
+		case strings.HasPrefix(value, "-"):
+			value, negative, stripped = value[1:], true, true
+		}
+	}
+
+	magnitude, signed, err := parseDecimal(nil, value, options.chunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// INFO: the value can only have one sign, Ex: +-5 is rejected
+	if stripped && signed {
+		return nil, ErrConvertingChunkToInteger
+	}
+
+	return newBigIntFromSigned(signedMagnitude{magnitude, negative || signed}, options.chunkSize), nil
+}
@@ -0,0 +1,194 @@
+package bignumber
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNewBigIntWithOptions(t *testing.T) {
+	tests := []struct {
+		input string
+		opts  []Option
+		want  string
+		err   error
+	}{
+		{
+			input: "123456789012345678901234567890",
+			opts:  nil,
+			want:  "123456789012345678901234567890",
+			err:   nil,
+		},
+		{
+			input: "123456789012345678901234567890",
+			opts:  []Option{WithChunkSize(4)},
+			want:  "123456789012345678901234567890",
+			err:   nil,
+		},
+		{
+			input: "123",
+			opts:  []Option{WithChunkSize(0)},
+			want:  "",
+			err:   ErrOutOfRange,
+		},
+		{
+			input: "123",
+			opts:  []Option{WithChunkSize(maxChunkSize + 1)},
+			want:  "",
+			err:   ErrOutOfRange,
+		},
+		{
+			input: "1,234_567",
+			opts:  []Option{WithSeparators(',', '_')},
+			want:  "1234567",
+			err:   nil,
+		},
+		{
+			input: "1,234_567",
+			opts:  []Option{WithSeparators(',')},
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "1.234",
+			opts:  []Option{WithSeparators('.')},
+			want:  "",
+			err:   ErrInvalidSeparator,
+		},
+		{
+			input: "1234",
+			opts:  []Option{WithSeparators('4')},
+			want:  "",
+			err:   ErrInvalidSeparator,
+		},
+		{
+			input: " +123 ",
+			opts:  []Option{AllowSign()},
+			want:  "123",
+			err:   nil,
+		},
+		{
+			input: "+123",
+			opts:  nil,
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "-000",
+			opts:  []Option{AllowSign()},
+			want:  "0",
+			err:   nil,
+		},
+		{
+			input: "-123",
+			opts:  []Option{AllowSign()},
+			want:  "-123",
+			err:   nil,
+		},
+		{
+			input: "-123",
+			opts:  []Option{WithChunkSize(2)},
+			want:  "-123",
+			err:   nil,
+		},
+		{
+			input: "-\"123\"",
+			opts:  []Option{AllowSign()},
+			want:  "-123",
+			err:   nil,
+		},
+		{
+			input: "+-123",
+			opts:  []Option{AllowSign()},
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "--123",
+			opts:  []Option{AllowSign()},
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "-1_000_000",
+			opts:  []Option{WithSeparators('_')},
+			want:  "-1000000",
+			err:   nil,
+		},
+		{
+			input: "+1_000_000",
+			opts:  []Option{AllowSign(), WithSeparators('_'), WithChunkSize(3)},
+			want:  "1000000",
+			err:   nil,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			bg, err := NewBigInt(tc.input, tc.opts...)
+			if err != tc.err {
+				t.Fatalf("got %v, want %v", err, tc.err)
+			}
+
+			if bg != nil && bg.String() != tc.want {
+				t.Errorf("got %v, want %v", bg.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestNewBigIntWithChunkSize(t *testing.T) {
+	value := MustNewBigInt("1234567890", WithChunkSize(3))
+
+	if got, want := value.NumChunks(), 4; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// INFO: the operands with other chunk sizes are converted to the receiver ones
+	tests := []struct {
+		got  *BigInt
+		want string
+	}{
+		{
+			got:  value.Add(MustNewBigInt("999999999999999999999")),
+			want: "1000000000001234567889",
+		},
+		{
+			got:  MustNewBigInt("999999999999999999999").Add(value),
+			want: "1000000000001234567889",
+		},
+		{
+			got:  value.Mul(MustNewBigInt("1000000000000000000")),
+			want: "1234567890000000000000000000",
+		},
+		{
+			got:  Sum([]*BigInt{value, MustNewBigInt("10"), MustNewBigInt("1", WithChunkSize(1))}),
+			want: "1234567901",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if tc.got.String() != tc.want {
+				t.Errorf("got %v, want %v", tc.got.String(), tc.want)
+			}
+		})
+	}
+
+	if value.Cmp(MustNewBigInt("1234567890")) != 0 {
+		t.Errorf("got %v, want equal values", value.Cmp(MustNewBigInt("1234567890")))
+	}
+
+	data, err := value.MarshalBinary()
+	if err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+
+	var decoded BigInt
+	if err := decoded.UnmarshalBinary(data); err != nil || decoded.String() != "1234567890" {
+		t.Errorf("got %v, %v, want %v", decoded.String(), err, "1234567890")
+	}
+}
@@ -0,0 +1,160 @@
+package bignumber
+
+import "strings"
+
+// decimalText is the set of types the decimal parser accepts,
+// it allows parsing byte slices without converting them to strings.
+type decimalText interface {
+	~string | ~[]byte
+}
+
+// ParseBytes creates a new BigInt from the decimal ASCII digits in data,
+// following the same rules as NewBigInt without converting data to a string.
+func ParseBytes(data []byte) (*BigInt, error) {
+	magnitude, negative, err := parseDecimal(nil, data, maxChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBigIntFromSigned(signedMagnitude{magnitude, negative}, maxChunkSize), nil
+}
+
+// NewBigIntFromGrouped creates a new BigInt from a string with the digits
+// grouped by thousands using sep, Ex: 1,234,567 or 1.234.567 with '.' as sep.
+// A sep of 0 uses the default separator ','. The value may have a '-' sign
+// before the first group, Ex: -1,234.
+//
+// INFO: the groups must have exactly 3 digits, except for the first one,
+// so a separator like '.' is never mistaken for a decimal point, Ex: 1.5
+// is rejected. A decimal point is never accepted with other separators.
+func NewBigIntFromGrouped(value string, sep rune) (*BigInt, error) {
+	sep = groupSeparator(sep)
+
+	if isDigit(sep) || sep == '"' || sep == '-' {
+		return nil, ErrInvalidSeparator
+	}
+
+	sign, digits := "", strings.Trim(value, asciiSpace)
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+
+	groups := strings.Split(digits, string(sep))
+
+	for idx, group := range groups {
+		if !isDigits(group) {
+			return nil, ErrInvalidIntegerNumber
+		}
+
+		switch {
+		case idx == 0 && len(groups) > 1 && (group == "" || len(group) > groupSize):
+			return nil, ErrInvalidIntegerNumber
+		case idx > 0 && len(group) != groupSize:
+			return nil, ErrInvalidIntegerNumber
+		}
+	}
+
+	return NewBigInt(sign + strings.Join(groups, ""))
+}
+
+// parseDecimal parses the decimal value into chunks of chunkSize digits,
+// reusing the capacity of dst, and reports whether it has a '-' sign. The
+// value is fully validated before dst is written, so dst is left untouched on error.
+func parseDecimal[T decimalText](dst []uint64, value T, chunkSize int) ([]uint64, bool, error) {
+	start, point, negative, err := decimalDigits(value)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Break the digits into chunks of chunkSize digits from the right,
+	// the first chunk takes the digits that don't fill a whole chunk
+	head := (point - start) % chunkSize
+	if head == 0 {
+		head = chunkSize
+	}
+
+	// INFO: the chunks are allocated upfront when dst is not large enough
+	magnitude := dst[:0]
+	if chunks := (point - start + chunkSize - 1) / chunkSize; cap(magnitude) < chunks {
+		magnitude = make([]uint64, 0, chunks)
+	}
+
+	for chunkStart, chunkEnd := start, start+head; chunkStart < point; chunkStart, chunkEnd = chunkEnd, chunkEnd+chunkSize {
+		var chunk uint64
+
+		for idx := chunkStart; idx < chunkEnd; idx++ {
+			chunk = chunk*10 + uint64(value[idx]-'0')
+		}
+
+		magnitude = append(magnitude, chunk)
+	}
+
+	return magnitude, negative, nil
+}
+
+// trimDecimal returns the bounds of the value without the surrounding
+// whitespace and a surrounding pair of quotes.
+func trimDecimal[T decimalText](value T) (start, end int) {
+	start, end = 0, len(value)
+
+	for start < end && strings.IndexByte(asciiSpace, value[start]) >= 0 {
+		start++
+	}
+
+	for end > start && strings.IndexByte(asciiSpace, value[end-1]) >= 0 {
+		end--
+	}
+
+	if end-start >= 2 && value[start] == '"' && value[end-1] == '"' {
+		start, end = start+1, end-1
+	}
+
+	return start, end
+}
+
+// decimalDigits validates the decimal value following the rules of NewBigInt and
+// returns the bounds of its integer digits, without the surrounding whitespace
+// and quotes, the sign or the zero decimal places, and whether the sign is '-'.
+func decimalDigits[T decimalText](value T) (start, end int, negative bool, err error) {
+	start, end = trimDecimal(value)
+
+	// INFO: the sign goes right before the digits, Ex: "-12" but not - 12
+	if start < end && value[start] == '-' {
+		start, negative = start+1, true
+	}
+
+	// Validate the digits up to the decimal point, if any
+	point := end
+
+	for idx := start; idx < end; idx++ {
+		if value[idx] == '.' {
+			point = idx
+
+			break
+		}
+
+		if !isDigit(rune(value[idx])) {
+			return 0, 0, false, ErrConvertingChunkToInteger
+		}
+	}
+
+	// INFO: only the zero decimal places are stripped, so a real
+	// fraction like 123.001 is never truncated to an integer
+	if point < end {
+		if point+1 == end {
+			return 0, 0, false, ErrInvalidIntegerNumber
+		}
+
+		for idx := point + 1; idx < end; idx++ {
+			if value[idx] != '0' {
+				return 0, 0, false, ErrInvalidIntegerNumber
+			}
+		}
+	}
+
+	if point == start {
+		return 0, 0, false, ErrConvertingChunkToInteger
+	}
+
+	return start, point, negative, nil
+}
@@ -0,0 +1,300 @@
+package bignumber
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"teladoc/internal/utils"
+)
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		err   error
+	}{
+		{
+			input: "0",
+			want:  "0",
+			err:   nil,
+		},
+		{
+			input: "42949672954294967295",
+			want:  "42949672954294967295",
+			err:   nil,
+		},
+		{
+			input: "000000000000000000123",
+			want:  "123",
+			err:   nil,
+		},
+		{
+			input: " \"123.000\"\n",
+			want:  "123",
+			err:   nil,
+		},
+		{
+			input: "-42949672954294967295",
+			want:  "-42949672954294967295",
+			err:   nil,
+		},
+		{
+			input: "123.001",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1 2",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: ".000",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			bg, err := ParseBytes([]byte(tc.input))
+			if err != tc.err {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+
+			if bg != nil && bg.String() != tc.want {
+				t.Errorf("got %v, want %v", bg.String(), tc.want)
+			}
+
+			// ParseBytes and NewBigInt must agree
+			_, stringErr := NewBigInt(tc.input)
+			if stringErr != err {
+				t.Errorf("got %v, want %v", stringErr, err)
+			}
+		})
+	}
+}
+
+func TestParseDecimalMatchesChunkString(t *testing.T) {
+	random := rand.New(rand.NewSource(164))
+
+	// INFO: the chunks with leading zeros, like the 000000001 of 1000000001,
+	// are the ones the older string based path used to get wrong
+	inputs := []string{"1000000001", "1000000000000000001", "100000000", "999999999"}
+	for range 200 {
+		inputs = append(inputs, randomNumber(random, 1+random.Intn(60)))
+	}
+
+	for idx, input := range inputs {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			chunks := utils.ChunkStringFromRight(input, maxChunkSize)
+
+			want := make([]uint64, len(chunks))
+			for idx, chunk := range chunks {
+				value, err := strconv.ParseUint(chunk, 10, 64)
+				if err != nil {
+					t.Fatalf("got %v, want nil", err)
+				}
+
+				want[idx] = value
+			}
+
+			got, negative, err := parseDecimal(nil, input, maxChunkSize)
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if !slices.Equal(got, want) || negative {
+				t.Errorf("got %v and %v, want %v and false", got, negative, want)
+			}
+
+			// The sign doesn't change the chunks
+			got, negative, _ = parseDecimal(nil, "-"+input, maxChunkSize)
+			if !slices.Equal(got, want) || !negative {
+				t.Errorf("got %v and %v, want %v and true", got, negative, want)
+			}
+		})
+	}
+}
+
+func TestParseDecimalAllocations(t *testing.T) {
+	value := strings.Repeat("1234567890", 10)
+	bg := MustNewBigInt(value)
+
+	// INFO: the digits go straight into the chunks, so parsing into
+	// a receiver with enough capacity doesn't allocate at all
+	allocs := testing.AllocsPerRun(1000, func() {
+		_ = bg.SetString(value)
+	})
+
+	if allocs != 0 {
+		t.Errorf("got %v allocations, want 0", allocs)
+	}
+}
+
+func BenchmarkParseBytes(b *testing.B) {
+	data := []byte(strings.Repeat("1234567890", 10))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseBytes(data)
+	}
+}
+
+func BenchmarkNewBigIntFromBytesString(b *testing.B) {
+	data := []byte(strings.Repeat("1234567890", 10))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = NewBigInt(string(data))
+	}
+}
+
+func TestNewBigIntFromGrouped(t *testing.T) {
+	tests := []struct {
+		input string
+		sep   rune
+		want  string
+		err   error
+	}{
+		{
+			input: "1,234,567",
+			sep:   0,
+			want:  "1234567",
+			err:   nil,
+		},
+		{
+			input: "1.234.567",
+			sep:   '.',
+			want:  "1234567",
+			err:   nil,
+		},
+		{
+			input: "123 456 789 012 345 678 901 234 567 890",
+			sep:   ' ',
+			want:  "123456789012345678901234567890",
+			err:   nil,
+		},
+		{
+			input: "1234",
+			sep:   ',',
+			want:  "1234",
+			err:   nil,
+		},
+		{
+			input: "1.5",
+			sep:   '.',
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1.234,00",
+			sep:   '.',
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1,234.000",
+			sep:   ',',
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1234,567",
+			sep:   ',',
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: ",234,567",
+			sep:   ',',
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1,,234",
+			sep:   ',',
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1,234",
+			sep:   '1',
+			want:  "",
+			err:   ErrInvalidSeparator,
+		},
+		{
+			input: "-1,234,567",
+			sep:   ',',
+			want:  "-1234567",
+			err:   nil,
+		},
+		{
+			input: "-,234",
+			sep:   ',',
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1-234",
+			sep:   '-',
+			want:  "",
+			err:   ErrInvalidSeparator,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			bg, err := NewBigIntFromGrouped(tc.input, tc.sep)
+			if err != tc.err {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+
+			if bg != nil && bg.String() != tc.want {
+				t.Errorf("got %v, want %v", bg.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestNewBigIntFromGroupedRoundTrip(t *testing.T) {
+	values := []string{"0", "12", "1234", "1000000", "123456789012345678901234567890"}
+
+	for idx, sep := range []rune{',', '.', ' ', '\''} {
+		for _, value := range values {
+			testname := fmt.Sprintf("test#%d-%s", idx, value)
+
+			t.Run(testname, func(t *testing.T) {
+				grouped := MustNewBigInt(value).FormatGrouped(sep)
+
+				got, err := NewBigIntFromGrouped(grouped, sep)
+				if err != nil {
+					t.Fatalf("got %v, want nil", err)
+				}
+
+				if got.String() != value {
+					t.Errorf("got %v, want %v", got.String(), value)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package bignumber
+
+import "math"
+
+// Pow raises the BigInt to an uint64 exponent using exponentiation by squaring.
+// The result of a negative BigInt is negative when the exponent is odd.
+func (b *BigInt) Pow(exponent uint64) *BigInt {
+	b = b.orZero()
+
+	result := powMagnitude(normalizeMagnitude(b.magnitude, b.base()), exponent, b.base())
+
+	return newBigIntFromSigned(signedMagnitude{result, b.negative && exponent%2 == 1}, b.chunkSize())
+}
+
+// PowBig raises the BigInt to a BigInt exponent using square-and-multiply
+// driven by the bits of the exponent. The maxDigits parameter guards against
+// exponents that would exhaust the memory: ErrResultTooLarge is returned when
+// the result would have more than maxDigits digits, a maxDigits <= 0 disables
+// the guard. A negative exponent returns ErrOutOfRange, and the result of a
+// negative BigInt is negative when the exponent is odd.
+//
+// INFO: Without a modulus the result grows linearly with the exponent,
+// so this is mainly intended to pair with a modular reduction.
+func (b *BigInt) PowBig(exponent *BigInt, maxDigits int) (*BigInt, error) {
+	b = b.orZero()
+
+	// A nil operand is treated as zero
+	exponent = exponent.orZero()
+
+	if exponent.negative {
+		return nil, ErrOutOfRange
+	}
+
+	base := b.base()
+	magnitude := normalizeMagnitude(b.magnitude, base)
+	words := toWords(exponent.magnitude, exponent.base())
+	negative := b.negative && len(words) > 0 && testWordBit(words, 0) == 1
+
+	// Handle the trivial cases: x^0 = 1, 0^x = 0 and 1^x = 1
+	switch {
+	case len(words) == 0:
+		return newBigIntFromMagnitude([]uint64{1}, b.chunkSize()), nil
+	case isZeroMagnitude(magnitude):
+		return newBigIntFromMagnitude([]uint64{0}, b.chunkSize()), nil
+	case len(magnitude) == 1 && magnitude[0] == 1:
+		return newBigIntFromSigned(signedMagnitude{[]uint64{1}, negative}, b.chunkSize()), nil
+	}
+
+	if maxDigits > 0 {
+		// Any exponent that doesn't fit in an uint64 is too large for a base >= 2
+		exponentValue, err := exponent.ToUint64()
+		if err != nil {
+			return nil, ErrResultTooLarge
+		}
+
+		// Estimate the number of digits of the result as exponent * log10(b)
+		log10 := math.Log10(float64(magnitude[0])) + float64(b.chunkSize()*(len(magnitude)-1))
+
+		if estimate := float64(exponentValue) * log10; estimate > float64(maxDigits) {
+			return nil, ErrResultTooLarge
+		}
+	}
+
+	result := []uint64{1}
+
+	for bit := wordsBitLen(words) - 1; bit >= 0; bit-- {
+		result = mulMagnitudes(result, result, base)
+
+		if testWordBit(words, bit) == 1 {
+			result = mulMagnitudes(result, magnitude, base)
+		}
+	}
+
+	return newBigIntFromSigned(signedMagnitude{result, negative}, b.chunkSize()), nil
+}
+
+// ExpMod returns the BigInt raised to exp modulo mod using square-and-multiply,
+// reducing the value after every step so it never grows past mod^2. The result
+// lies in [0, |mod|) like EuclideanMod, Ex: (-2)^3 mod 5 is 2.
+//
+// ExpMod has no error result, so the invalid operands panic instead of
+// returning ErrDivisionByZero or ErrOutOfRange: a zero or nil mod panics like
+// an integer division by zero, and a negative exp panics since it needs the
+// modular inverse of the BigInt, raise the result of ModInverse to -exp for
+// those. Both can be checked upfront with IsZero and Sign.
+func (b *BigInt) ExpMod(exp, mod *BigInt) *BigInt {
+	b = b.orZero()
+
+	// A nil operand is treated as zero
+	exp, mod = exp.orZero(), mod.inChunksOf(b.chunkSize())
+
+	modulus := mod.signed().magnitude
+
+	switch {
+	case isZeroMagnitude(modulus):
+		panic("bignumber: ExpMod with a zero modulus")
+	case exp.negative:
+		panic("bignumber: ExpMod with a negative exponent")
+	}
+
+	// INFO: the reduced value is never negative, so the powers are reduced
+	// magnitudes and the sign of the base is applied by EuclideanMod first
+	reduced, _ := b.EuclideanMod(mod)
+
+	base := b.base()
+	magnitude := reduced.magnitude
+	words := toWords(exp.signed().magnitude, exp.base())
+
+	// INFO: x^0 mod 1 is 0, the reduction of the initial 1 covers it
+	_, result := quoRemMagnitudes([]uint64{1}, modulus, base)
+
+	for bit := wordsBitLen(words) - 1; bit >= 0; bit-- {
+		_, result = quoRemMagnitudes(mulMagnitudes(result, result, base), modulus, base)
+
+		if testWordBit(words, bit) == 1 {
+			_, result = quoRemMagnitudes(mulMagnitudes(result, magnitude, base), modulus, base)
+		}
+	}
+
+	return newBigIntFromMagnitude(result, b.chunkSize())
+}
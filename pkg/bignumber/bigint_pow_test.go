@@ -0,0 +1,407 @@
+package bignumber
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestBigIntPowBig(t *testing.T) {
+	tests := []struct {
+		base     string
+		exponent string
+	}{
+		{
+			base:     "0",
+			exponent: "0",
+		},
+		{
+			base:     "0",
+			exponent: "5",
+		},
+		{
+			base:     "1",
+			exponent: "123456789012345678901234567890",
+		},
+		{
+			base:     "2",
+			exponent: "0",
+		},
+		{
+			base:     "2",
+			exponent: "10",
+		},
+		{
+			base:     "2",
+			exponent: "4096",
+		},
+		{
+			base:     "3",
+			exponent: "1000",
+		},
+		{
+			base:     "1000000000",
+			exponent: "7",
+		},
+		{
+			base:     "123456789012345678901234567890",
+			exponent: "17",
+		},
+		{
+			base:     "-1",
+			exponent: "123456789012345678901234567891",
+		},
+		{
+			base:     "-3",
+			exponent: "1000",
+		},
+		{
+			base:     "-123456789012345678901234567890",
+			exponent: "17",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(tc.base).PowBig(MustNewBigInt(tc.exponent), 1_000_000)
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			base, _ := new(big.Int).SetString(tc.base, 10)
+			exponent, _ := new(big.Int).SetString(tc.exponent, 10)
+			want := new(big.Int).Exp(base, exponent, nil)
+
+			if got.String() != want.String() {
+				t.Errorf("got %v, want %v", got.String(), want.String())
+			}
+
+			// PowBig and the integer exponentiation must agree
+			exponentValue, err := MustNewBigInt(tc.exponent).ToUint64()
+			if err == nil {
+				pow := MustNewBigInt(tc.base).Pow(exponentValue)
+
+				if got.Cmp(pow) != 0 {
+					t.Errorf("got %v, want %v", got.String(), pow.String())
+				}
+			}
+		})
+	}
+}
+
+func TestBigIntPowBigTooLarge(t *testing.T) {
+	tests := []struct {
+		base     string
+		exponent string
+	}{
+		{
+			base:     "2",
+			exponent: "100000000",
+		},
+		{
+			base:     "2",
+			exponent: "123456789012345678901234567890",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			_, err := MustNewBigInt(tc.base).PowBig(MustNewBigInt(tc.exponent), 1_000_000)
+			if err != ErrResultTooLarge {
+				t.Errorf("got %v, want %v", err, ErrResultTooLarge)
+			}
+		})
+	}
+}
+
+func TestBigIntPowBigNegativeExponent(t *testing.T) {
+	if _, err := MustNewBigInt("2").PowBig(MustNewBigInt("-3"), 0); err != ErrOutOfRange {
+		t.Errorf("got %v, want %v", err, ErrOutOfRange)
+	}
+}
+
+func TestBigIntPowBigWithoutLimit(t *testing.T) {
+	got, err := MustNewBigInt("10").PowBig(MustNewBigInt("20"), 0)
+	if err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+
+	if want := "100000000000000000000"; got.String() != want {
+		t.Errorf("got %v, want %v", got.String(), want)
+	}
+}
+
+func TestBigIntPowBigKeepsChunkSize(t *testing.T) {
+	tests := []struct {
+		base     string
+		exponent string
+		want     string
+	}{
+		{
+			base:     "123",
+			exponent: "0",
+			want:     "1",
+		},
+		{
+			base:     "0",
+			exponent: "5",
+			want:     "0",
+		},
+		{
+			base:     "1",
+			exponent: "5",
+			want:     "1",
+		},
+		{
+			base:     "12",
+			exponent: "5",
+			want:     "248832",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(tc.base, WithChunkSize(3)).PowBig(MustNewBigInt(tc.exponent), 0)
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+
+			if got.chunkSize() != 3 {
+				t.Errorf("got chunk size %v, want %v", got.chunkSize(), 3)
+			}
+		})
+	}
+}
+
+func TestBigIntPow(t *testing.T) {
+	tests := []struct {
+		base     string
+		exponent uint64
+	}{
+		{
+			base:     "0",
+			exponent: 0,
+		},
+		{
+			base:     "0",
+			exponent: 3,
+		},
+		{
+			base:     "7",
+			exponent: 1,
+		},
+		{
+			base:     "2",
+			exponent: 4096,
+		},
+		{
+			base:     "1000000000",
+			exponent: 5,
+		},
+		{
+			base:     "123456789012345678901234567890",
+			exponent: 9,
+		},
+		{
+			base:     "-7",
+			exponent: 0,
+		},
+		{
+			base:     "-7",
+			exponent: 2,
+		},
+		{
+			base:     "-123456789012345678901234567890",
+			exponent: 9,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			base, _ := new(big.Int).SetString(tc.base, 10)
+			want := new(big.Int).Exp(base, new(big.Int).SetUint64(tc.exponent), nil)
+
+			if got := MustNewBigInt(tc.base).Pow(tc.exponent); got.String() != want.String() {
+				t.Errorf("got %v, want %v", got.String(), want.String())
+			}
+		})
+	}
+}
+
+func TestBigIntPowAgainstBig(t *testing.T) {
+	random := rand.New(rand.NewSource(255))
+
+	for idx := range 100 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		input := randomNumber(random, 1+random.Intn(60))
+		exponent := uint64(random.Intn(300))
+		chunkSize := 1 + random.Intn(maxChunkSize)
+
+		t.Run(testname, func(t *testing.T) {
+			base, _ := new(big.Int).SetString(input, 10)
+			want := new(big.Int).Exp(base, new(big.Int).SetUint64(exponent), nil)
+
+			if got := MustNewBigInt(input, WithChunkSize(chunkSize)).Pow(exponent); got.String() != want.String() {
+				t.Errorf("%v^%v: got %v digits, want %v digits", input, exponent, len(got.String()), len(want.String()))
+			}
+		})
+	}
+}
+
+func TestBigIntPowUnnormalizedChunks(t *testing.T) {
+	// INFO: a chunk that doesn't fit in the chunk size, built by hand
+	value := &BigInt{magnitude: []uint64{math.MaxUint64}, chukSize: maxChunkSize}
+	want := new(big.Int).Exp(new(big.Int).SetUint64(math.MaxUint64), big.NewInt(5), nil)
+
+	if got := value.Pow(5); got.String() != want.String() {
+		t.Errorf("got %v, want %v", got.String(), want.String())
+	}
+
+	if got := (&BigInt{}).Pow(5); got.String() != "0" {
+		t.Errorf("got %v, want %v", got.String(), "0")
+	}
+}
+
+func TestBigIntExpMod(t *testing.T) {
+	tests := []struct {
+		base     string
+		exponent string
+		modulus  string
+	}{
+		{
+			base:     "0",
+			exponent: "0",
+			modulus:  "7",
+		},
+		{
+			base:     "5",
+			exponent: "0",
+			modulus:  "1",
+		},
+		{
+			base:     "2",
+			exponent: "10",
+			modulus:  "1000",
+		},
+		{
+			base:     "4",
+			exponent: "13",
+			modulus:  "497",
+		},
+		{
+			base:     "-2",
+			exponent: "3",
+			modulus:  "5",
+		},
+		{
+			base:     "-2",
+			exponent: "3",
+			modulus:  "-5",
+		},
+		{
+			base:     "123456789012345678901234567890",
+			exponent: "98765432109876543210",
+			modulus:  "1000000007",
+		},
+		{
+			// INFO: Fermat's little theorem with the Mersenne prime 2^127 - 1
+			base:     "3",
+			exponent: "170141183460469231731687303715884105726",
+			modulus:  "170141183460469231731687303715884105727",
+		},
+		{
+			base:     "987654321098765432109876543210987654321",
+			exponent: "123456789123456789123456789",
+			modulus:  "340282366920938463463374607431768211507",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			base, _ := new(big.Int).SetString(tc.base, 10)
+			exponent, _ := new(big.Int).SetString(tc.exponent, 10)
+			modulus, _ := new(big.Int).SetString(tc.modulus, 10)
+			want := new(big.Int).Exp(base, exponent, modulus)
+
+			for chunkSize := 1; chunkSize <= maxChunkSize; chunkSize += 17 {
+				value := MustNewBigInt(tc.base).inChunksOf(chunkSize)
+
+				if got := value.ExpMod(MustNewBigInt(tc.exponent), MustNewBigInt(tc.modulus)); got.String() != want.String() {
+					t.Errorf("chunk size %d: got %v, want %v", chunkSize, got.String(), want.String())
+				}
+			}
+		})
+	}
+}
+
+func TestBigIntExpModPanics(t *testing.T) {
+	tests := []struct {
+		exponent *BigInt
+		modulus  *BigInt
+	}{
+		{
+			exponent: MustNewBigInt("3"),
+			modulus:  NewZero(),
+		},
+		{
+			exponent: MustNewBigInt("3"),
+			modulus:  nil,
+		},
+		{
+			exponent: MustNewBigInt("-3"),
+			modulus:  MustNewBigInt("7"),
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("got no panic, want panic")
+				}
+			}()
+
+			MustNewBigInt("2").ExpMod(tc.exponent, tc.modulus)
+		})
+	}
+}
+
+func BenchmarkBigIntPow(b *testing.B) {
+	for _, tc := range []struct {
+		base     string
+		exponent uint64
+	}{
+		{base: "2", exponent: 4096},
+		{base: "3", exponent: 100000},
+		{base: "123456789012345678901234567890", exponent: 3000},
+	} {
+		value := MustNewBigInt(tc.base)
+
+		b.Run(fmt.Sprintf("digits=%d/exponent=%d", len(tc.base), tc.exponent), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				value.Pow(tc.exponent)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package bignumber
+
+import "slices"
+
+// Sqrt returns the floor of the square root of the BigInt using the Newton's
+// method. It panics when the BigInt is negative, like `big.Int.Sqrt`.
+func (b *BigInt) Sqrt() *BigInt {
+	b = b.orZero()
+
+	if b.negative {
+		panic("bignumber: square root of a negative number")
+	}
+
+	return newBigIntFromMagnitude(sqrtMagnitude(normalizeMagnitude(b.magnitude, b.base()), b.base()), b.chunkSize())
+}
+
+// IsPerfectSquare reports whether the BigInt is the square of an integer,
+// Ex: 0, 1, 4, 9, 16, etc. The negative values are never squares.
+func (b *BigInt) IsPerfectSquare() bool {
+	b = b.orZero()
+
+	if b.negative {
+		return false
+	}
+
+	magnitude := normalizeMagnitude(b.magnitude, b.base())
+
+	// INFO: the squares never end in 2, 3, 7 or 8,
+	// so they can be rejected without computing the square root
+	switch divModUint64(nil, magnitude, b.base(), 10) {
+	case 2, 3, 7, 8:
+		return false
+	}
+
+	root := sqrtMagnitude(magnitude, b.base())
+
+	return cmpMagnitudes(mulMagnitudes(root, root, b.base()), magnitude) == 0
+}
+
+// Root returns the floor of the nth root of the BigInt using the Newton's
+// method, Ex: the cube root of 30 is 3. The odd roots of a negative BigInt
+// are truncated toward zero like Quo, Ex: the cube root of -30 is -3.
+// It returns ErrZeroRoot when n is zero, and ErrNegativeRoot when n is
+// even and the BigInt is negative.
+func (b *BigInt) Root(n uint) (*BigInt, error) {
+	b = b.orZero()
+
+	switch {
+	case n == 0:
+		return nil, ErrZeroRoot
+	case n%2 == 0 && b.Sign() < 0:
+		return nil, ErrNegativeRoot
+	}
+
+	magnitude := normalizeMagnitude(b.magnitude, b.base())
+
+	switch n {
+	case 1:
+		magnitude = slices.Clone(magnitude)
+	case 2:
+		magnitude = sqrtMagnitude(magnitude, b.base())
+	default:
+		magnitude = rootMagnitude(magnitude, uint64(n), b.base())
+	}
+
+	return newBigIntFromSigned(signedMagnitude{magnitude, b.negative}, b.chunkSize()), nil
+}
+
+// IsPerfectPower reports whether the BigInt is an integer base raised to an
+// exponent of at least 2, and returns the smallest such base with its exponent,
+// Ex: 1024 returns 2 and 10, not 4 and 5 or 32 and 2. A negative value is a
+// perfect power of a negative base when the exponent is odd, Ex: -8 returns
+// -2 and 3.
+//
+// INFO: 0 and 1 are powers of themselves for every exponent, so they are
+// returned with the exponent 2, and -1 with the exponent 3.
+func (b *BigInt) IsPerfectPower() (base *BigInt, exp uint, ok bool) {
+	b = b.orZero()
+
+	// The roots are taken on the absolute value
+	abs := &BigInt{magnitude: normalizeMagnitude(b.magnitude, b.base()), chukSize: b.chukSize}
+
+	if abs.CmpInt64(1) <= 0 {
+		if b.negative {
+			return newBigIntFromSigned(signedMagnitude{[]uint64{1}, true}, b.chunkSize()), 3, true
+		}
+
+		return newBigIntFromMagnitude(slices.Clone(abs.magnitude), b.chunkSize()), 2, true
+	}
+
+	root, exp, ok := perfectPowerOf(abs, 1, b.negative)
+	if !ok {
+		return nil, 0, false
+	}
+
+	return newBigIntFromSigned(signedMagnitude{root.magnitude, b.negative}, b.chunkSize()), exp, true
+}
+
+// perfectPowerOf returns the smallest base of the value greater than 1 and
+// its exponent times factor, only odd exponents are tried when odd is true.
+//
+// INFO: when the value is r^p for a prime p, the exponent of its smallest
+// base is a multiple of p, so the smallest base of r is the one of the value.
+// Only the prime exponents are tried, every root is lower than 2 past BitLen-1.
+func perfectPowerOf(value *BigInt, factor uint, odd bool) (*BigInt, uint, bool) {
+	for exp := uint64(2); exp <= uint64(value.BitLen()-1); exp = nextTrialDivisor(exp) {
+		// The even powers are never negative
+		if (odd && exp == 2) || !isSmallPrime(exp) {
+			continue
+		}
+
+		root, _ := value.Root(uint(exp))
+
+		if root.Pow(exp).CmpAbs(value) == 0 {
+			if base, baseExp, ok := perfectPowerOf(root, factor*uint(exp), odd); ok {
+				return base, baseExp, true
+			}
+
+			return root, factor * uint(exp), true
+		}
+	}
+
+	return nil, 0, false
+}
+
+// isSmallPrime reports whether n is a prime using trial division.
+func isSmallPrime(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+
+	for divisor := uint64(2); divisor <= n/divisor; divisor = nextTrialDivisor(divisor) {
+		if n%divisor == 0 {
+			return false
+		}
+	}
+
+	return true
+}
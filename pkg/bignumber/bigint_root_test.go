@@ -0,0 +1,413 @@
+package bignumber
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestBigIntSqrt(t *testing.T) {
+	tests := []string{
+		"0",
+		"1",
+		"3",
+		"4",
+		"99",
+		"1000000000",
+		"999999999999999999",
+		"1000000000000000000",
+		"123456789012345678901234567890",
+		"152415787532388367504942236884722755800955129",
+		"340282366920938463463374607431768211455",
+		"1000000000000000000000000000000000000000000000000000000000000000000000000",
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value, _ := new(big.Int).SetString(tc, 10)
+			want := new(big.Int).Sqrt(value)
+
+			if got := MustNewBigInt(tc).Sqrt(); got.String() != want.String() {
+				t.Errorf("got %v, want %v", got.String(), want.String())
+			}
+		})
+	}
+}
+
+func TestBigIntSqrtUnnormalizedChunks(t *testing.T) {
+	// INFO: a chunk that doesn't fit in the chunk size, built by hand
+	value := &BigInt{magnitude: []uint64{math.MaxUint64}, chukSize: maxChunkSize}
+	want := new(big.Int).Sqrt(new(big.Int).SetUint64(math.MaxUint64))
+
+	if got := value.Sqrt(); got.String() != want.String() {
+		t.Errorf("got %v, want %v", got.String(), want.String())
+	}
+
+	if value.IsPerfectSquare() {
+		t.Errorf("got %v, want %v", true, false)
+	}
+}
+
+func TestBigIntSqrtPanics(t *testing.T) {
+	for idx, input := range []string{"-1", "-123456789012345678901234567890"} {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("got no panic, want panic")
+				}
+			}()
+
+			MustNewBigInt(input).Sqrt()
+		})
+	}
+}
+
+func TestBigIntIsPerfectSquare(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{
+			value: "0",
+			want:  true,
+		},
+		{
+			value: "1",
+			want:  true,
+		},
+		{
+			value: "2",
+			want:  false,
+		},
+		{
+			value: "16",
+			want:  true,
+		},
+		{
+			value: "1000000000000000000",
+			want:  true,
+		},
+		{
+			value: "15241578753238836750495351562536198787501905199875019052100",
+			want:  true,
+		},
+		{
+			value: "15241578753238836750495351562536198787501905199875019052101",
+			want:  false,
+		},
+		{
+			value: "15241578753238836750495351562536198787501905199875019052099",
+			want:  false,
+		},
+		{
+			value: "15241578753238836750495351562536198787501905199875019052103",
+			want:  false,
+		},
+		{
+			value: "340282366920938463463374607431768211456",
+			want:  true,
+		},
+		{
+			value: "340282366920938463463374607431768211457",
+			want:  false,
+		},
+		{
+			value: "-16",
+			want:  false,
+		},
+		{
+			value: "-0",
+			want:  true,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := MustNewBigInt(tc.value).IsPerfectSquare(); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntRoot(t *testing.T) {
+	tests := []struct {
+		value string
+		n     uint
+		want  string
+	}{
+		{
+			value: "0",
+			n:     3,
+			want:  "0",
+		},
+		{
+			value: "123456789012345678901234567890",
+			n:     1,
+			want:  "123456789012345678901234567890",
+		},
+		{
+			value: "152415787532388367504942236884722755800955129",
+			n:     2,
+			want:  "12345678901234567890123",
+		},
+		{
+			value: "27",
+			n:     3,
+			want:  "3",
+		},
+		{
+			value: "26",
+			n:     3,
+			want:  "2",
+		},
+		{
+			value: "1881676372353657731338003115679818096684294558605752",
+			n:     3,
+			want:  "123456789012345678",
+		},
+		{
+			value: "1881676372353657731338003115679818096684294558605751",
+			n:     3,
+			want:  "123456789012345677",
+		},
+		{
+			value: "28679718617337040377865705392147950633130929309718386641755512534332294200561877268836384261970494501",
+			n:     5,
+			want:  "123456789012345678901",
+		},
+		{
+			value: "1267650600228229401496703205376",
+			n:     5,
+			want:  "1048576",
+		},
+		{
+			value: "1267650600228229401496703205376",
+			n:     200,
+			want:  "1",
+		},
+		{
+			value: "9999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999",
+			n:     7,
+			want:  "193069772888325",
+		},
+		{
+			value: "565085372752072980342678183289434977475742957749700040579795019008914216750701873815058364295655955831793421391675602114139188083986184374993484852497195388592723473261154873851984113181784042210836641942358458972396730076644302418807862367224748698320887797948102452579875972374791640134676825925938751748048377598396405715025535503493909292789051975204727599713268595353493435424473785717284114372450300850805698464384107500620320187002316777958049295411195687233185388663976102621575632410154472084332898867268926030088504942718069428862020735153512586120297662521759907105908005674658111115695465286885260079474122097531565111154131436228496127521570470348416954855586646426708090597829268119510957574923029628420932296251445214202360638900000000000000000000000000000000000000000",
+			n:     41,
+			want:  "12345678901234567890",
+		},
+		{
+			value: "565085372752072980342678183289434977475742957749700040579795019008914216750701873815058364295655955831793421391675602114139188083986184374993484852497195388592723473261154873851984113181784042210836641942358458972396730076644302418807862367224748698320887797948102452579875972374791640134676825925938751748048377598396405715025535503493909292789051975204727599713268595353493435424473785717284114372450300850805698464384107500620320187002316777958049295411195687233185388663976102621575632410154472084332898867268926030088504942718069428862020735153512586120297662521759907105908005674658111115695465286885260079474122097531565111154131436228496127521570470348416954855586646426708090597829268119510957574923029628420932296251445214202360638899999999999999999999999999999999999999999",
+			n:     41,
+			want:  "12345678901234567889",
+		},
+		{
+			value: "-27",
+			n:     3,
+			want:  "-3",
+		},
+		{
+			value: "-30",
+			n:     3,
+			want:  "-3",
+		},
+		{
+			value: "-1881676372353657731338003115679818096684294558605751",
+			n:     3,
+			want:  "-123456789012345677",
+		},
+		{
+			value: "-123456789012345678901234567890",
+			n:     1,
+			want:  "-123456789012345678901234567890",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(tc.value).Root(tc.n)
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntRootAgainstBig(t *testing.T) {
+	random := rand.New(rand.NewSource(263))
+
+	for idx := range 200 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		input := randomNumber(random, 1+random.Intn(80))
+		n := uint(1 + random.Intn(12))
+		chunkSize := 1 + random.Intn(maxChunkSize)
+
+		if n%2 == 1 && random.Intn(2) == 0 {
+			input = "-" + input
+		}
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(input, WithChunkSize(chunkSize)).Root(n)
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			// The root r satisfies |r|^n <= |value| < (|r|+1)^n with the sign of the value
+			value, _ := new(big.Int).SetString(input, 10)
+			root, _ := new(big.Int).SetString(got.String(), 10)
+			exponent := big.NewInt(int64(n))
+
+			abs, absRoot := new(big.Int).Abs(value), new(big.Int).Abs(root)
+			next := new(big.Int).Add(absRoot, big.NewInt(1))
+
+			if new(big.Int).Exp(absRoot, exponent, nil).Cmp(abs) > 0 || new(big.Int).Exp(next, exponent, nil).Cmp(abs) <= 0 {
+				t.Errorf("root %v of %v: got %v", n, input, got)
+			}
+
+			if root.Sign() != 0 && root.Sign() != value.Sign() {
+				t.Errorf("root %v of %v: got %v, want the sign of the value", n, input, got)
+			}
+		})
+	}
+}
+
+func TestBigIntRootNegative(t *testing.T) {
+	for idx, n := range []uint{2, 4, 10} {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if _, err := MustNewBigInt("-16").Root(n); err != ErrNegativeRoot {
+				t.Errorf("got %v, want %v", err, ErrNegativeRoot)
+			}
+		})
+	}
+}
+
+func TestBigIntRootZero(t *testing.T) {
+	if _, err := MustNewBigInt("8").Root(0); err != ErrZeroRoot {
+		t.Errorf("got %v, want %v", err, ErrZeroRoot)
+	}
+}
+
+func TestBigIntIsPerfectPower(t *testing.T) {
+	tests := []struct {
+		value string
+		base  string
+		exp   uint
+		ok    bool
+	}{
+		{
+			value: "0",
+			base:  "0",
+			exp:   2,
+			ok:    true,
+		},
+		{
+			value: "1",
+			base:  "1",
+			exp:   2,
+			ok:    true,
+		},
+		{
+			value: "2",
+			ok:    false,
+		},
+		{
+			value: "12",
+			ok:    false,
+		},
+		{
+			value: "1024",
+			base:  "2",
+			exp:   10,
+			ok:    true,
+		},
+		{
+			value: "216",
+			base:  "6",
+			exp:   3,
+			ok:    true,
+		},
+		{
+			value: "36",
+			base:  "6",
+			exp:   2,
+			ok:    true,
+		},
+		{
+			value: "1000000000000000000000000000000000000",
+			base:  "10",
+			exp:   36,
+			ok:    true,
+		},
+		{
+			value: "515377520732011331036461129765621272702107522001",
+			base:  "3",
+			exp:   100,
+			ok:    true,
+		},
+		{
+			// INFO: the square of the prime 2^61 - 1
+			value: "5316911983139663487003542222693990401",
+			base:  "2305843009213693951",
+			exp:   2,
+			ok:    true,
+		},
+		{
+			value: "5316911983139663487003542222693990402",
+			ok:    false,
+		},
+		{
+			value: "-8",
+			base:  "-2",
+			exp:   3,
+			ok:    true,
+		},
+		{
+			value: "-64",
+			base:  "-4",
+			exp:   3,
+			ok:    true,
+		},
+		{
+			value: "-4",
+			ok:    false,
+		},
+		{
+			value: "-1",
+			base:  "-1",
+			exp:   3,
+			ok:    true,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			base, exp, ok := MustNewBigInt(tc.value).IsPerfectPower()
+			if ok != tc.ok || exp != tc.exp {
+				t.Fatalf("got %v and %v, want %v and %v", exp, ok, tc.exp, tc.ok)
+			}
+
+			if ok && base.String() != tc.base {
+				t.Errorf("got %v, want %v", base.String(), tc.base)
+			}
+		})
+	}
+}
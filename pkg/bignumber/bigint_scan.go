@@ -0,0 +1,46 @@
+package bignumber
+
+import "fmt"
+
+// Scan implements the fmt.Scanner interface, it consumes a run of decimal
+// digits skipping the leading spaces and stops at the first non-digit.
+// The digits may have a leading '-' sign. The supported verbs are %d, %s and %v.
+func (b *BigInt) Scan(state fmt.ScanState, verb rune) error {
+	switch verb {
+	case 'd', 's', 'v':
+	default:
+		return ErrUnsupportedVerb
+	}
+
+	state.SkipSpace()
+
+	sign := ""
+	if char, _, err := state.ReadRune(); err == nil {
+		if char == '-' {
+			sign = "-"
+		} else if err := state.UnreadRune(); err != nil {
+			return err
+		}
+	}
+
+	token, err := state.Token(false, isDigit)
+	if err != nil {
+		return err
+	}
+
+	if len(token) == 0 {
+		return ErrEmptyNumber
+	}
+
+	bigInt, err := NewBigInt(sign + string(token))
+	if err != nil {
+		return err
+	}
+
+	return b.set(bigInt)
+}
+
+// isDigit reports whether the rune is a decimal digit.
+func isDigit(char rune) bool {
+	return char >= '0' && char <= '9'
+}
@@ -0,0 +1,87 @@
+package bignumber
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestBigIntScan(t *testing.T) {
+	var lhs, rhs BigInt
+
+	count, err := fmt.Sscan("123 123456789012345678901234567890", &lhs, &rhs)
+	if err != nil || count != 2 {
+		t.Fatalf("got %v, %v, want %v, %v", count, err, 2, nil)
+	}
+
+	if lhs.String() != "123" {
+		t.Errorf("got %v, want %v", lhs.String(), "123")
+	}
+
+	if rhs.String() != "123456789012345678901234567890" {
+		t.Errorf("got %v, want %v", rhs.String(), "123456789012345678901234567890")
+	}
+}
+
+func TestBigIntScanf(t *testing.T) {
+	tests := []struct {
+		input  string
+		format string
+		want   string
+		err    error
+	}{
+		{
+			input:  "456",
+			format: "%d",
+			want:   "456",
+			err:    nil,
+		},
+		{
+			input:  "456,789",
+			format: "%d",
+			want:   "456",
+			err:    nil,
+		},
+		{
+			input:  "abc",
+			format: "%d",
+			want:   "0",
+			err:    ErrEmptyNumber,
+		},
+		{
+			input:  "-456",
+			format: "%d",
+			want:   "-456",
+			err:    nil,
+		},
+		{
+			input:  "-abc",
+			format: "%d",
+			want:   "0",
+			err:    ErrEmptyNumber,
+		},
+		{
+			input:  "456",
+			format: "%x",
+			want:   "0",
+			err:    ErrUnsupportedVerb,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := NewZero()
+
+			_, err := fmt.Sscanf(tc.input, tc.format, got)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
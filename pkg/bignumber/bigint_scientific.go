@@ -0,0 +1,90 @@
+package bignumber
+
+import (
+	"strconv"
+	"strings"
+)
+
+// maxScientificExponent is the largest exponent accepted by
+// NewBigIntFromScientific for a non-zero mantissa.
+//
+// INFO: the shift allocates a chunk per chunkSize digits of the exponent,
+// 10^8 digits are about 44MB of chunks, anything larger is far beyond what
+// the arithmetic can handle in practice.
+const maxScientificExponent = 100_000_000
+
+// NewBigIntFromScientific creates a new BigInt from a number in the
+// scientific notation, a mantissa followed by a non-negative exponent
+// The mantissa may have a '-' sign and decimal places as long as the result is an integer,
+// the exponent may have a '+' sign. Like NewBigInt, the surrounding whitespace and
+// a surrounding pair of quotes are ignored. It returns ErrOutOfRange when the
+// exponent of a non-zero mantissa is above 10^8.
+//
+// Ex: 12e3, 1E100, 1.5e1, -2e3, 1e+3, etc.
+func NewBigIntFromScientific(value string) (*BigInt, error) {
+	start, end := trimDecimal(value)
+
+	mantissa, exponent, found := strings.Cut(strings.ToLower(value[start:end]), "e")
+	if !found {
+		return NewBigInt(value)
+	}
+
+	// INFO: only the '+' sign is stripped here, so negative exponents are invalid
+	exponent = strings.TrimPrefix(exponent, "+")
+
+	if exponent == "" || !isDigits(exponent) {
+		return nil, ErrInvalidIntegerNumber
+	}
+
+	shift, err := strconv.ParseUint(exponent, 10, 0)
+	if err != nil {
+		return nil, ErrOutOfRange
+	}
+
+	sign, digits := "", mantissa
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+
+	integer, fraction, _ := strings.Cut(digits, ".")
+	if integer+fraction == "" || !isDigits(integer) || !isDigits(fraction) {
+		return nil, ErrInvalidIntegerNumber
+	}
+
+	// The decimal places consume the exponent, the ones that are
+	// left must be zeros for the result to be an integer
+	if uint64(len(fraction)) > shift {
+		if strings.TrimRight(fraction[shift:], "0") != "" {
+			return nil, ErrInvalidIntegerNumber
+		}
+
+		fraction = fraction[:shift]
+	}
+
+	bigInt, err := NewBigInt(sign + integer + fraction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Zero stays zero whatever the exponent
+	if bigInt.IsZero() {
+		return bigInt, nil
+	}
+
+	if shift > maxScientificExponent {
+		return nil, ErrOutOfRange
+	}
+
+	return bigInt.ShiftLeft(uint(shift) - uint(len(fraction))), nil
+}
+
+// isDigits reports whether every byte of the string is a decimal digit.
+func isDigits(value string) bool {
+	for idx := 0; idx < len(value); idx++ {
+		if !isDigit(rune(value[idx])) {
+			return false
+		}
+	}
+
+	return true
+}
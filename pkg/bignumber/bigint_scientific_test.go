@@ -0,0 +1,166 @@
+package bignumber
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestNewBigIntFromScientific(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		err   error
+	}{
+		{
+			input: "12e3",
+			want:  "12000",
+			err:   nil,
+		},
+		{
+			input: "1e100",
+			want:  "1" + strings.Repeat("0", 100),
+			err:   nil,
+		},
+		{
+			input: "1E0",
+			want:  "1",
+			err:   nil,
+		},
+		{
+			input: "123",
+			want:  "123",
+			err:   nil,
+		},
+		{
+			input: "1.5e1",
+			want:  "15",
+			err:   nil,
+		},
+		{
+			input: "1.2500e2",
+			want:  "125",
+			err:   nil,
+		},
+		{
+			input: "0.000000000012345678901e21",
+			want:  "12345678901",
+			err:   nil,
+		},
+		{
+			input: "-1.5e1",
+			want:  "-15",
+			err:   nil,
+		},
+		{
+			input: "-0e5",
+			want:  "0",
+			err:   nil,
+		},
+		{
+			input: "--1e1",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1.55e1",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "12e-3",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "12e",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "e3",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1x2e3",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1e99999999999999999999",
+			want:  "",
+			err:   ErrOutOfRange,
+		},
+		{
+			input: "1e999999999999999999",
+			want:  "",
+			err:   ErrOutOfRange,
+		},
+		{
+			input: "1e100000001",
+			want:  "",
+			err:   ErrOutOfRange,
+		},
+		{
+			input: "0e999999999999999999",
+			want:  "0",
+			err:   nil,
+		},
+		{
+			input: " 12e3",
+			want:  "12000",
+			err:   nil,
+		},
+		{
+			input: "12e3\n",
+			want:  "12000",
+			err:   nil,
+		},
+		{
+			input: "\"12e3\"",
+			want:  "12000",
+			err:   nil,
+		},
+		{
+			input: " \"-1.5E+1\" ",
+			want:  "-15",
+			err:   nil,
+		},
+		{
+			input: "1e+3",
+			want:  "1000",
+			err:   nil,
+		},
+		{
+			input: "1e++3",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1e+",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "1 e3",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			bg, err := NewBigIntFromScientific(tc.input)
+			if err != tc.err {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+
+			if bg != nil && bg.String() != tc.want {
+				t.Errorf("got %v, want %v", bg.String(), tc.want)
+			}
+		})
+	}
+}
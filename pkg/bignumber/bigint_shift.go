@@ -0,0 +1,72 @@
+package bignumber
+
+import "slices"
+
+// ShiftLeft returns the BigInt multiplied by 10^n, this is
+// the decimal version of the bit shift, Ex: 12 << 3 is 12000.
+func (b *BigInt) ShiftLeft(n uint) *BigInt {
+	b = b.orZero()
+
+	chunkSize := uint(b.chunkSize())
+
+	if isZeroMagnitude(b.magnitude) {
+		return newBigIntFromMagnitude([]uint64{0}, b.chunkSize())
+	}
+
+	// Shift the digits that don't fill a whole chunk,
+	// then append the zero chunks for the rest
+	magnitude := mulMagnitudeUint64(normalizeMagnitude(b.magnitude, b.base()), b.base(), powersOfTen[n%chunkSize])
+	magnitude = append(magnitude, make([]uint64, n/chunkSize)...)
+
+	return newBigIntFromSigned(signedMagnitude{magnitude, b.negative}, b.chunkSize())
+}
+
+// ShiftRight returns the BigInt divided by 10^n and truncated toward zero like
+// Quo, this is the decimal version of the bit shift, Ex: 12345 >> 3 is 12 and
+// -12345 >> 3 is -12.
+func (b *BigInt) ShiftRight(n uint) *BigInt {
+	b = b.orZero()
+
+	chunkSize := uint(b.chunkSize())
+	magnitude := normalizeMagnitude(b.magnitude, b.base())
+
+	// Drop the whole chunks, then divide by the digits that don't fill one
+	drop := n / chunkSize
+	if drop >= uint(len(magnitude)) {
+		return newBigIntFromMagnitude([]uint64{0}, b.chunkSize())
+	}
+
+	magnitude = magnitude[:uint(len(magnitude))-drop]
+
+	quotient := make([]uint64, len(magnitude))
+	divModUint64(quotient, magnitude, b.base(), powersOfTen[n%chunkSize])
+
+	return newBigIntFromSigned(signedMagnitude{quotient, b.negative}, b.chunkSize())
+}
+
+// RoundToPowerOfTen returns the BigInt rounded to the nearest multiple of
+// 10^exp, the halves are rounded away from zero, Ex: 1450 rounded to the
+// hundreds is 1500 and -1450 is -1500. Every BigInt is a multiple of 10^exp
+// when exp is not positive, so it's returned as is.
+func (b *BigInt) RoundToPowerOfTen(exp int) *BigInt {
+	b = b.orZero()
+
+	if exp <= 0 {
+		return newBigIntFromSigned(signedMagnitude{slices.Clone(normalizeMagnitude(b.magnitude, b.base())), b.negative}, b.chunkSize())
+	}
+
+	// The absolute value is rounded, then the sign goes back on it
+	abs := &BigInt{magnitude: b.magnitude, chukSize: b.chukSize}
+
+	n := uint(exp)
+	rounded := abs.ShiftRight(n)
+
+	// INFO: the first dropped digit decides the rounding direction
+	if digit, _ := abs.ShiftRight(n - 1).ModUint32(10); digit >= 5 {
+		rounded = rounded.Inc()
+	}
+
+	rounded = rounded.ShiftLeft(n)
+
+	return newBigIntFromSigned(signedMagnitude{rounded.magnitude, b.negative}, b.chunkSize())
+}
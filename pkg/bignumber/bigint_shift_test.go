@@ -0,0 +1,236 @@
+package bignumber
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestBigIntShiftLeft(t *testing.T) {
+	tests := []struct {
+		input string
+		n     uint
+		want  string
+	}{
+		{
+			input: "0",
+			n:     20,
+			want:  "0",
+		},
+		{
+			input: "12",
+			n:     0,
+			want:  "12",
+		},
+		{
+			input: "12",
+			n:     3,
+			want:  "12000",
+		},
+		{
+			input: "123456789",
+			n:     9,
+			want:  "123456789000000000",
+		},
+		{
+			input: "987654321",
+			n:     13,
+			want:  "9876543210000000000000",
+		},
+		{
+			input: "1",
+			n:     100,
+			want:  "1" + strings.Repeat("0", 100),
+		},
+		{
+			input: "-987654321",
+			n:     13,
+			want:  "-9876543210000000000000",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := MustNewBigInt(tc.input).ShiftLeft(tc.n)
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+
+			if want := len(strings.TrimPrefix(tc.want, "-")); got.Length() != want {
+				t.Errorf("got %v, want %v", got.Length(), want)
+			}
+		})
+	}
+}
+
+func TestBigIntShiftRight(t *testing.T) {
+	tests := []struct {
+		input string
+		n     uint
+		want  string
+	}{
+		{
+			input: "0",
+			n:     20,
+			want:  "0",
+		},
+		{
+			input: "12",
+			n:     0,
+			want:  "12",
+		},
+		{
+			input: "12345",
+			n:     3,
+			want:  "12",
+		},
+		{
+			input: "12345",
+			n:     5,
+			want:  "0",
+		},
+		{
+			input: "123456789000000000",
+			n:     9,
+			want:  "123456789",
+		},
+		{
+			input: "9876543210000000000000",
+			n:     13,
+			want:  "987654321",
+		},
+		{
+			input: "1" + strings.Repeat("0", 100),
+			n:     100,
+			want:  "1",
+		},
+		{
+			input: "123",
+			n:     100,
+			want:  "0",
+		},
+		{
+			input: "-12345",
+			n:     3,
+			want:  "-12",
+		},
+		{
+			input: "-12345",
+			n:     5,
+			want:  "0",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := MustNewBigInt(tc.input).ShiftRight(tc.n)
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+
+			if want := len(strings.TrimPrefix(tc.want, "-")); got.Length() != want {
+				t.Errorf("got %v, want %v", got.Length(), want)
+			}
+		})
+	}
+}
+
+func TestBigIntRoundToPowerOfTen(t *testing.T) {
+	tests := []struct {
+		input string
+		exp   int
+		want  string
+	}{
+		{
+			input: "0",
+			exp:   2,
+			want:  "0",
+		},
+		{
+			input: "1450",
+			exp:   2,
+			want:  "1500",
+		},
+		{
+			input: "1449",
+			exp:   2,
+			want:  "1400",
+		},
+		{
+			input: "1400",
+			exp:   2,
+			want:  "1400",
+		},
+		{
+			input: "49",
+			exp:   2,
+			want:  "0",
+		},
+		{
+			input: "50",
+			exp:   2,
+			want:  "100",
+		},
+		{
+			input: "999999999",
+			exp:   1,
+			want:  "1000000000",
+		},
+		{
+			input: "123456789123456789",
+			exp:   9,
+			want:  "123456789000000000",
+		},
+		{
+			input: "123",
+			exp:   0,
+			want:  "123",
+		},
+		{
+			input: "123",
+			exp:   -3,
+			want:  "123",
+		},
+		{
+			input: "123",
+			exp:   10,
+			want:  "0",
+		},
+		{
+			input: "-1450",
+			exp:   2,
+			want:  "-1500",
+		},
+		{
+			input: "-1449",
+			exp:   2,
+			want:  "-1400",
+		},
+		{
+			input: "-49",
+			exp:   2,
+			want:  "0",
+		},
+		{
+			input: "-49",
+			exp:   0,
+			want:  "-49",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := MustNewBigInt(tc.input).RoundToPowerOfTen(tc.exp); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
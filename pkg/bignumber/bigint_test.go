@@ -2,6 +2,12 @@ package bignumber
 
 import (
 	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -26,6 +32,97 @@ func TestNewBigInt(t *testing.T) {
 			want:  "",
 			err:   ErrConvertingChunkToInteger,
 		},
+		{
+			input: "123.000",
+			want:  "123",
+			err:   nil,
+		},
+		{
+			input: "123456789012345678901234567890.0",
+			want:  "123456789012345678901234567890",
+			err:   nil,
+		},
+		{
+			input: "123.001",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "123.",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "123.0.0",
+			want:  "",
+			err:   ErrInvalidIntegerNumber,
+		},
+		{
+			input: "  123\n",
+			want:  "123",
+			err:   nil,
+		},
+		{
+			input: "\t\"123456789012345678901234567890\" ",
+			want:  "123456789012345678901234567890",
+			err:   nil,
+		},
+		{
+			input: "1 2",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "\"123",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "\" 123\"",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "-123456789012345678901234567890",
+			want:  "-123456789012345678901234567890",
+			err:   nil,
+		},
+		{
+			input: " \"-123.00\"\n",
+			want:  "-123",
+			err:   nil,
+		},
+		{
+			// INFO: zero is never negative
+			input: "-000",
+			want:  "0",
+			err:   nil,
+		},
+		{
+			input: "-",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "--123",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "- 123",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "12-3",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: "+123",
+			want:  "",
+			err:   ErrConvertingChunkToInteger,
+		},
 	}
 
 	for idx, tc := range tests {
@@ -112,3 +209,2557 @@ func TestBigIntAdd(t *testing.T) {
 		})
 	}
 }
+
+func TestBigIntAddRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(165))
+
+	for idx := range 200 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			lhs, rhs := randomNumber(random, 1+random.Intn(80)), randomNumber(random, 1+random.Intn(80))
+
+			want, _ := new(big.Int).SetString(lhs, 10)
+			addend, _ := new(big.Int).SetString(rhs, 10)
+			want.Add(want, addend)
+
+			if got := MustNewBigInt(lhs).Add(MustNewBigInt(rhs)); got.String() != want.String() {
+				t.Errorf("%v + %v: got %v, want %v", lhs, rhs, got, want)
+			}
+		})
+	}
+}
+
+func TestBigIntArithmeticAgainstBig(t *testing.T) {
+	random := rand.New(rand.NewSource(166))
+
+	// INFO: the sizes around the multiples of the chunk size stress the carries
+	// and the borrows that cross the chunks, and the quotient estimates
+	sizes := []int{1, 17, 18, 19, 35, 36, 37, 54, 55, 100, 400}
+
+	for idx := range 500 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			lhs := randomNumber(random, sizes[random.Intn(len(sizes))])
+			rhs := randomNumber(random, sizes[random.Intn(len(sizes))])
+
+			lhsInt, _ := new(big.Int).SetString(lhs, 10)
+			rhsInt, _ := new(big.Int).SetString(rhs, 10)
+
+			lhsValue, rhsValue := MustNewBigInt(lhs), MustNewBigInt(rhs)
+
+			if got := lhsValue.String(); got != lhs {
+				t.Errorf("String: got %v, want %v", got, lhs)
+			}
+
+			if got := lhsValue.Add(rhsValue).String(); got != new(big.Int).Add(lhsInt, rhsInt).String() {
+				t.Errorf("%v + %v: got %v", lhs, rhs, got)
+			}
+
+			if got := lhsValue.Mul(rhsValue).String(); got != new(big.Int).Mul(lhsInt, rhsInt).String() {
+				t.Errorf("%v * %v: got %v", lhs, rhs, got)
+			}
+
+			if got := lhsValue.Sub(rhsValue).String(); got != new(big.Int).Sub(lhsInt, rhsInt).String() {
+				t.Errorf("%v - %v: got %v", lhs, rhs, got)
+			}
+
+			quotient, _ := lhsValue.Div(rhsValue)
+			remainder, _ := lhsValue.Mod(rhsValue)
+			wantQuotient, wantRemainder := new(big.Int).QuoRem(lhsInt, rhsInt, new(big.Int))
+
+			if quotient.String() != wantQuotient.String() || remainder.String() != wantRemainder.String() {
+				t.Errorf("%v / %v: got %v rem %v, want %v rem %v", lhs, rhs, quotient, remainder, wantQuotient, wantRemainder)
+			}
+
+			if got := NewBigIntFromBytes(lhsValue.Bytes()).String(); got != lhs {
+				t.Errorf("Bytes: got %v, want %v", got, lhs)
+			}
+
+			data, err := lhsValue.MarshalBinary()
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			var decoded BigInt
+			if err := decoded.UnmarshalBinary(data); err != nil || decoded.String() != lhs {
+				t.Errorf("MarshalBinary: got %v, %v, want %v", decoded.String(), err, lhs)
+			}
+		})
+	}
+}
+
+func TestBigIntAddMaxWidthChunks(t *testing.T) {
+	for chunkSize := 1; chunkSize <= maxChunkSize; chunkSize++ {
+		testname := fmt.Sprintf("test#%d", chunkSize-1)
+
+		t.Run(testname, func(t *testing.T) {
+			// INFO: the largest value that fits in a single chunk
+			maxChunk := powersOfTen[chunkSize] - 1
+			value := MustNewBigInt(strconv.FormatUint(maxChunk, 10), WithChunkSize(chunkSize))
+
+			want := []uint64{1, maxChunk - 1}
+			wantString := strconv.FormatUint(2*maxChunk, 10)
+
+			sum := value.Add(value)
+			if !slices.Equal(sum.Magnitude(), want) || sum.String() != wantString {
+				t.Errorf("got %v (%v), want %v (%v)", sum.Magnitude(), sum, want, wantString)
+			}
+
+			inPlace := MustNewBigInt(strconv.FormatUint(maxChunk, 10), WithChunkSize(chunkSize))
+			_ = inPlace.AddInPlace(value)
+
+			if !slices.Equal(inPlace.Magnitude(), want) || inPlace.String() != wantString {
+				t.Errorf("got %v (%v), want %v (%v)", inPlace.Magnitude(), inPlace, want, wantString)
+			}
+		})
+	}
+}
+
+func TestBigIntAddUnnormalizedChunks(t *testing.T) {
+	// INFO: chunks that don't fit in the chunk size, up to the largest uint64, built by hand
+	newMaxChunk := func(chunkSize int) *BigInt {
+		return &BigInt{magnitude: []uint64{math.MaxUint64}, chukSize: chunkSize}
+	}
+
+	for idx, chunkSize := range []int{1, 9, maxChunkSize} {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			want := new(big.Int).Lsh(new(big.Int).SetUint64(math.MaxUint64), 1).String()
+
+			if got := newMaxChunk(chunkSize).Add(newMaxChunk(chunkSize)); got.String() != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+
+			inPlace := newMaxChunk(chunkSize)
+			_ = inPlace.AddInPlace(newMaxChunk(chunkSize))
+
+			if inPlace.Cmp(MustNewBigInt(want)) != 0 {
+				t.Errorf("got %v, want %v", inPlace.Magnitude(), want)
+			}
+
+			var accumulator Accumulator
+
+			accumulator.Add(newMaxChunk(chunkSize))
+			accumulator.Add(newMaxChunk(chunkSize))
+
+			if got := accumulator.Sum(); got.String() != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBigIntAddInPlace(t *testing.T) {
+	tests := []struct {
+		lhs    string
+		rhs    string
+		result string
+	}{
+		{
+			lhs:    "123",
+			rhs:    "456",
+			result: "579",
+		},
+		{
+			lhs:    "999999999",
+			rhs:    "1",
+			result: "1000000000",
+		},
+		{
+			lhs:    "12345678",
+			rhs:    "123456789012345678901",
+			result: "123456789012358024579",
+		},
+		{
+			lhs:    "000000000000000000123",
+			rhs:    "877",
+			result: "1000",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := MustNewBigInt(tc.lhs)
+
+			if err := got.AddInPlace(MustNewBigInt(tc.rhs)); err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != tc.result {
+				t.Errorf("got %v, want %v", got.String(), tc.result)
+			}
+
+			if got.Length() != len(tc.result) {
+				t.Errorf("got %v, want %v", got.Length(), len(tc.result))
+			}
+		})
+	}
+}
+
+func TestBigIntAddInPlaceFrozen(t *testing.T) {
+	if err := Zero.AddInPlace(One); err != ErrFrozen {
+		t.Errorf("got %v, want %v", err, ErrFrozen)
+	}
+}
+
+func TestBigIntResultsAreIndependent(t *testing.T) {
+	tests := []struct {
+		op   func(lhs, rhs *BigInt) *BigInt
+		lhs  string
+		rhs  string
+		want string
+	}{
+		{
+			op:   (*BigInt).Add,
+			lhs:  "123456789012345678901234567890",
+			rhs:  "0",
+			want: "123456789012345678901234567890",
+		},
+		{
+			op:   (*BigInt).Add,
+			lhs:  "0",
+			rhs:  "123456789012345678901234567890",
+			want: "123456789012345678901234567890",
+		},
+		{
+			op:   (*BigInt).Sub,
+			lhs:  "123456789012345678901234567890",
+			rhs:  "0",
+			want: "123456789012345678901234567890",
+		},
+		{
+			op:   (*BigInt).Mul,
+			lhs:  "123456789012345678901234567890",
+			rhs:  "1",
+			want: "123456789012345678901234567890",
+		},
+		{
+			op: func(lhs, rhs *BigInt) *BigInt {
+				quotient, _ := lhs.Div(rhs)
+
+				return quotient
+			},
+			lhs:  "123456789012345678901234567890",
+			rhs:  "1",
+			want: "123456789012345678901234567890",
+		},
+		{
+			op: func(lhs, rhs *BigInt) *BigInt {
+				remainder, _ := lhs.Mod(rhs)
+
+				return remainder
+			},
+			lhs:  "123456789012345678901234567890",
+			rhs:  "123456789012345678901234567891",
+			want: "123456789012345678901234567890",
+		},
+		{
+			op: func(lhs, rhs *BigInt) *BigInt {
+				return lhs.MulAdd(rhs, nil)
+			},
+			lhs:  "123456789012345678901234567890",
+			rhs:  "1",
+			want: "123456789012345678901234567890",
+		},
+		{
+			op: func(lhs, _ *BigInt) *BigInt {
+				return lhs.Pow(1)
+			},
+			lhs:  "123456789012345678901234567890",
+			rhs:  "0",
+			want: "123456789012345678901234567890",
+		},
+		{
+			op: func(lhs, _ *BigInt) *BigInt {
+				return lhs.ShiftLeft(0)
+			},
+			lhs:  "123456789012345678901234567890",
+			rhs:  "0",
+			want: "123456789012345678901234567890",
+		},
+		{
+			op: func(lhs, _ *BigInt) *BigInt {
+				return lhs.RoundToPowerOfTen(0)
+			},
+			lhs:  "123456789012345678901234567890",
+			rhs:  "0",
+			want: "123456789012345678901234567890",
+		},
+		{
+			op: func(lhs, rhs *BigInt) *BigInt {
+				return Sum([]*BigInt{lhs, rhs})
+			},
+			lhs:  "123456789012345678901234567890",
+			rhs:  "0",
+			want: "123456789012345678901234567890",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			lhs, rhs := MustNewBigInt(tc.lhs), MustNewBigInt(tc.rhs)
+			result := tc.op(lhs, rhs)
+
+			// INFO: adding one reuses the chunks in place, so any shared
+			// chunk would show up in the other values
+			_ = lhs.AddInPlace(NewOne())
+			_ = rhs.AddInPlace(NewOne())
+
+			if result.String() != tc.want {
+				t.Errorf("got %v, want %v", result.String(), tc.want)
+			}
+
+			_ = result.SubInPlace(result)
+
+			if lhs.String() != MustNewBigInt(tc.lhs).Inc().String() {
+				t.Errorf("got %v, want %v", lhs.String(), MustNewBigInt(tc.lhs).Inc().String())
+			}
+
+			if rhs.String() != MustNewBigInt(tc.rhs).Inc().String() {
+				t.Errorf("got %v, want %v", rhs.String(), MustNewBigInt(tc.rhs).Inc().String())
+			}
+		})
+	}
+}
+
+func TestBigIntChainedResultsAreIndependent(t *testing.T) {
+	a, b, c := MustNewBigInt("100"), MustNewBigInt("200"), MustNewBigInt("300")
+
+	ab := a.Add(b)
+	abc := ab.Add(c)
+
+	_ = ab.AddInPlace(MustNewBigInt("1000"))
+	_ = a.SubInPlace(a)
+
+	for _, tc := range []struct {
+		got  *BigInt
+		want string
+	}{
+		{got: a, want: "0"},
+		{got: b, want: "200"},
+		{got: c, want: "300"},
+		{got: ab, want: "1300"},
+		{got: abc, want: "600"},
+	} {
+		if tc.got.String() != tc.want {
+			t.Errorf("got %v, want %v", tc.got.String(), tc.want)
+		}
+	}
+}
+
+func TestBigIntSub(t *testing.T) {
+	tests := []struct {
+		lhs    string
+		rhs    string
+		result string
+	}{
+		{
+			lhs:    "579",
+			rhs:    "456",
+			result: "123",
+		},
+		{
+			lhs:    "1000000000",
+			rhs:    "1",
+			result: "999999999",
+		},
+		{
+			lhs:    "1000000000000000000000000000000",
+			rhs:    "1",
+			result: "999999999999999999999999999999",
+		},
+		{
+			lhs:    "123456789012358024579",
+			rhs:    "123456789012345678901",
+			result: "12345678",
+		},
+		{
+			lhs:    "42",
+			rhs:    "000000000000000000042",
+			result: "0",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := MustNewBigInt(tc.lhs).Sub(MustNewBigInt(tc.rhs))
+
+			if got.String() != tc.result {
+				t.Errorf("got %v, want %v", got.String(), tc.result)
+			}
+
+			inPlace := MustNewBigInt(tc.lhs)
+
+			if err := inPlace.SubInPlace(MustNewBigInt(tc.rhs)); err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if inPlace.String() != tc.result {
+				t.Errorf("got %v, want %v", inPlace.String(), tc.result)
+			}
+
+			if inPlace.Length() != len(tc.result) {
+				t.Errorf("got %v, want %v", inPlace.Length(), len(tc.result))
+			}
+		})
+	}
+}
+
+func TestBigIntSubBorrowAcrossChunks(t *testing.T) {
+	tests := []struct {
+		lhs string
+		rhs string
+	}{
+		{
+			lhs: "1" + strings.Repeat("0", 60),
+			rhs: "1",
+		},
+		{
+			lhs: "1" + strings.Repeat("0", 60) + "5",
+			rhs: "6",
+		},
+		{
+			lhs: "1" + strings.Repeat("0", 60),
+			rhs: strings.Repeat("9", 60),
+		},
+		{
+			lhs: "5" + strings.Repeat("0", 40) + "1",
+			rhs: "4" + strings.Repeat("9", 41),
+		},
+		{
+			lhs: "1",
+			rhs: "1" + strings.Repeat("0", 60),
+		},
+		{
+			lhs: strings.Repeat("0", 30) + "1" + strings.Repeat("0", 30),
+			rhs: strings.Repeat("0", 45) + "1",
+		},
+	}
+
+	for idx, tc := range tests {
+		lhs, _ := new(big.Int).SetString(tc.lhs, 10)
+		rhs, _ := new(big.Int).SetString(tc.rhs, 10)
+		want := new(big.Int).Sub(lhs, rhs).String()
+
+		// INFO: every chunk size puts the borrows on different chunk boundaries
+		for chunkSize := 1; chunkSize <= maxChunkSize; chunkSize++ {
+			testname := fmt.Sprintf("test#%d/chunk#%d", idx, chunkSize)
+
+			t.Run(testname, func(t *testing.T) {
+				got := MustNewBigInt(tc.lhs, WithChunkSize(chunkSize)).Sub(MustNewBigInt(tc.rhs))
+
+				if got.String() != want {
+					t.Errorf("got %v, want %v", got.String(), want)
+				}
+
+				inPlace := MustNewBigInt(tc.lhs, WithChunkSize(chunkSize))
+
+				if err := inPlace.SubInPlace(MustNewBigInt(tc.rhs, WithChunkSize(chunkSize))); err != nil {
+					t.Fatalf("got %v, want nil", err)
+				}
+
+				if inPlace.String() != want {
+					t.Errorf("got %v, want %v", inPlace.String(), want)
+				}
+			})
+		}
+	}
+}
+
+func TestBigIntSubSigned(t *testing.T) {
+	tests := []struct {
+		lhs    string
+		rhs    string
+		result string
+	}{
+		{
+			lhs:    "5",
+			rhs:    "8",
+			result: "-3",
+		},
+		{
+			lhs:    "8",
+			rhs:    "5",
+			result: "3",
+		},
+		{
+			lhs:    "-5",
+			rhs:    "8",
+			result: "-13",
+		},
+		{
+			lhs:    "5",
+			rhs:    "-8",
+			result: "13",
+		},
+		{
+			lhs:    "-5",
+			rhs:    "-8",
+			result: "3",
+		},
+		{
+			lhs:    "-8",
+			rhs:    "-5",
+			result: "-3",
+		},
+		{
+			lhs:    "8",
+			rhs:    "8",
+			result: "0",
+		},
+		{
+			lhs:    "-8",
+			rhs:    "-8",
+			result: "0",
+		},
+		{
+			lhs:    "0",
+			rhs:    "123456789012345678901234567890",
+			result: "-123456789012345678901234567890",
+		},
+		{
+			lhs:    "1",
+			rhs:    "1000000000000000000",
+			result: "-999999999999999999",
+		},
+		{
+			lhs:    "-999999999999999999",
+			rhs:    "1",
+			result: "-1000000000000000000",
+		},
+		{
+			lhs:    "123456789012345678901",
+			rhs:    "123456789012358024579",
+			result: "-12345678",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := MustNewBigInt(tc.lhs).Sub(MustNewBigInt(tc.rhs))
+
+			if got.String() != tc.result {
+				t.Errorf("got %v, want %v", got.String(), tc.result)
+			}
+
+			inPlace := MustNewBigInt(tc.lhs)
+
+			if err := inPlace.SubInPlace(MustNewBigInt(tc.rhs)); err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if inPlace.String() != tc.result {
+				t.Errorf("got %v, want %v", inPlace.String(), tc.result)
+			}
+
+			// INFO: the sign is not a digit
+			if want := len(strings.TrimPrefix(tc.result, "-")); inPlace.Length() != want {
+				t.Errorf("got %v, want %v", inPlace.Length(), want)
+			}
+
+			// A zero difference is never negative
+			if tc.result == "0" && (got.negative || inPlace.negative) {
+				t.Errorf("got a negative zero, want a positive one")
+			}
+		})
+	}
+}
+
+func TestBigIntAddSigned(t *testing.T) {
+	tests := []struct {
+		lhs    string
+		rhs    string
+		result string
+	}{
+		{
+			lhs:    "5",
+			rhs:    "8",
+			result: "13",
+		},
+		{
+			lhs:    "-5",
+			rhs:    "8",
+			result: "3",
+		},
+		{
+			lhs:    "5",
+			rhs:    "-8",
+			result: "-3",
+		},
+		{
+			lhs:    "-5",
+			rhs:    "-8",
+			result: "-13",
+		},
+		{
+			lhs:    "-8",
+			rhs:    "8",
+			result: "0",
+		},
+		{
+			lhs:    "-999999999999999999",
+			rhs:    "-1",
+			result: "-1000000000000000000",
+		},
+		{
+			lhs:    "-1000000000000000000",
+			rhs:    "1",
+			result: "-999999999999999999",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := MustNewBigInt(tc.lhs).Add(MustNewBigInt(tc.rhs)); got.String() != tc.result {
+				t.Errorf("got %v, want %v", got.String(), tc.result)
+			}
+
+			inPlace := MustNewBigInt(tc.lhs)
+
+			if err := inPlace.AddInPlace(MustNewBigInt(tc.rhs)); err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if inPlace.String() != tc.result {
+				t.Errorf("got %v, want %v", inPlace.String(), tc.result)
+			}
+		})
+	}
+}
+
+func TestBigIntSignedHelpers(t *testing.T) {
+	tests := []struct {
+		got  *BigInt
+		want string
+	}{
+		{
+			got:  MustNewBigInt("-1").Inc(),
+			want: "0",
+		},
+		{
+			got:  MustNewBigInt("-1000000000000000000").Inc(),
+			want: "-999999999999999999",
+		},
+		{
+			got:  MustNewBigInt("-5").Half(),
+			want: "-2",
+		},
+		{
+			got:  MustNewBigInt("-1").Half(),
+			want: "0",
+		},
+		{
+			got:  MustNewBigInt("-3").MulScalar(4),
+			want: "-12",
+		},
+		{
+			got:  MustNewBigInt("-3").MulScalar(0),
+			want: "0",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if tc.got.String() != tc.want {
+				t.Errorf("got %v, want %v", tc.got.String(), tc.want)
+			}
+
+			// A zero result is never negative
+			if tc.want == "0" && tc.got.negative {
+				t.Errorf("got a negative zero, want a positive one")
+			}
+		})
+	}
+
+	if MustNewBigInt("-1").IsOne() {
+		t.Errorf("got %v, want %v", true, false)
+	}
+}
+
+func TestBigIntDouble(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: "0",
+			want:  "0",
+		},
+		{
+			input: "21",
+			want:  "42",
+		},
+		{
+			input: "500000000000000000",
+			want:  "1000000000000000000",
+		},
+		{
+			input: "-999999999999999999",
+			want:  "-1999999999999999998",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value := MustNewBigInt(tc.input)
+
+			if got := value.Double(); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+
+			if got := value.Double().Half(); got.Cmp(value) != 0 {
+				t.Errorf("got %v, want %v", got, value)
+			}
+		})
+	}
+}
+
+func TestBigIntSignedArithmeticAgainstBig(t *testing.T) {
+	random := rand.New(rand.NewSource(174))
+
+	sizes := []int{1, 17, 18, 19, 36, 37, 100}
+
+	// randomSigned returns a random number with a random sign
+	randomSigned := func() string {
+		value := randomNumber(random, sizes[random.Intn(len(sizes))])
+		if random.Intn(2) == 0 && value != "0" {
+			return "-" + value
+		}
+
+		return value
+	}
+
+	for idx := range 500 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			lhs, rhs := randomSigned(), randomSigned()
+
+			lhsInt, _ := new(big.Int).SetString(lhs, 10)
+			rhsInt, _ := new(big.Int).SetString(rhs, 10)
+
+			lhsValue, rhsValue := MustNewBigInt(lhs), MustNewBigInt(rhs)
+
+			if got := lhsValue.String(); got != lhs {
+				t.Errorf("String: got %v, want %v", got, lhs)
+			}
+
+			if got := lhsValue.Cmp(rhsValue); got != lhsInt.Cmp(rhsInt) {
+				t.Errorf("%v cmp %v: got %v", lhs, rhs, got)
+			}
+
+			if got := lhsValue.Add(rhsValue).String(); got != new(big.Int).Add(lhsInt, rhsInt).String() {
+				t.Errorf("%v + %v: got %v", lhs, rhs, got)
+			}
+
+			if got := lhsValue.Sub(rhsValue).String(); got != new(big.Int).Sub(lhsInt, rhsInt).String() {
+				t.Errorf("%v - %v: got %v", lhs, rhs, got)
+			}
+
+			if got := lhsValue.Mul(rhsValue).String(); got != new(big.Int).Mul(lhsInt, rhsInt).String() {
+				t.Errorf("%v * %v: got %v", lhs, rhs, got)
+			}
+
+			if got := lhsValue.MulAdd(rhsValue, lhsValue).String(); got != new(big.Int).Add(new(big.Int).Mul(lhsInt, rhsInt), lhsInt).String() {
+				t.Errorf("%v * %v + %v: got %v", lhs, rhs, lhs, got)
+			}
+
+			quotient, _ := lhsValue.Div(rhsValue)
+			remainder, _ := lhsValue.Mod(rhsValue)
+			euclidean, _ := lhsValue.EuclideanMod(rhsValue)
+			wantQuotient, wantRemainder := new(big.Int).QuoRem(lhsInt, rhsInt, new(big.Int))
+
+			if quotient.String() != wantQuotient.String() || remainder.String() != wantRemainder.String() {
+				t.Errorf("%v / %v: got %v rem %v, want %v rem %v", lhs, rhs, quotient, remainder, wantQuotient, wantRemainder)
+			}
+
+			if want := new(big.Int).Mod(lhsInt, rhsInt); euclidean.String() != want.String() {
+				t.Errorf("%v mod %v: got %v, want %v", lhs, rhs, euclidean, want)
+			}
+
+			inPlace := MustNewBigInt(lhs)
+			_ = inPlace.AddInPlace(rhsValue)
+			_ = inPlace.SubInPlace(lhsValue)
+
+			if inPlace.Cmp(rhsValue) != 0 {
+				t.Errorf("%v + %v - %v: got %v", lhs, rhs, lhs, inPlace)
+			}
+		})
+	}
+}
+
+func TestBigIntMul(t *testing.T) {
+	tests := []struct {
+		lhs    string
+		rhs    string
+		result string
+	}{
+		{
+			lhs:    "0",
+			rhs:    "123456789012345678901",
+			result: "0",
+		},
+		{
+			lhs:    "999999999",
+			rhs:    "999999999",
+			result: "999999998000000001",
+		},
+		{
+			lhs:    "123456789012345678901",
+			rhs:    "12345678",
+			result: "1524157764060357776403139878",
+		},
+		{
+			lhs:    "340282366920938463463374607431768211455",
+			rhs:    "340282366920938463463374607431768211455",
+			result: "115792089237316195423570985008687907852589419931798687112530834793049593217025",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := MustNewBigInt(tc.lhs).Mul(MustNewBigInt(tc.rhs))
+
+			if got.String() != tc.result {
+				t.Errorf("got %v, want %v", got.String(), tc.result)
+			}
+		})
+	}
+}
+
+func TestBigIntDiv(t *testing.T) {
+	tests := []struct {
+		lhs    string
+		rhs    string
+		result string
+	}{
+		{
+			lhs:    "0",
+			rhs:    "7",
+			result: "0",
+		},
+		{
+			lhs:    "6",
+			rhs:    "7",
+			result: "0",
+		},
+		{
+			lhs:    "123",
+			rhs:    "10",
+			result: "12",
+		},
+		{
+			lhs:    "999999998000000001",
+			rhs:    "999999999",
+			result: "999999999",
+		},
+		{
+			lhs:    "115792089237316195423570985008687907852589419931798687112530834793049593217025",
+			rhs:    "340282366920938463463374607431768211455",
+			result: "340282366920938463463374607431768211455",
+		},
+		{
+			lhs:    "123456789012345678901234567890",
+			rhs:    "987654321098765",
+			result: "124999998860937",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(tc.lhs).Div(MustNewBigInt(tc.rhs))
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != tc.result {
+				t.Errorf("got %v, want %v", got.String(), tc.result)
+			}
+		})
+	}
+}
+
+func TestBigIntDivByZero(t *testing.T) {
+	if _, err := MustNewBigInt("1").Div(NewZero()); err != ErrDivisionByZero {
+		t.Errorf("got %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestBigIntDivExact(t *testing.T) {
+	tests := []struct {
+		lhs    string
+		rhs    string
+		result string
+		err    error
+	}{
+		{
+			lhs:    "0",
+			rhs:    "7",
+			result: "0",
+			err:    nil,
+		},
+		{
+			lhs:    "999999998000000001",
+			rhs:    "999999999",
+			result: "999999999",
+			err:    nil,
+		},
+		{
+			lhs:    "265252859812191058636308480000000",
+			rhs:    "2432902008176640000",
+			result: "109027350432000",
+			err:    nil,
+		},
+		{
+			lhs:    "123",
+			rhs:    "10",
+			result: "",
+			err:    ErrInexact,
+		},
+		{
+			lhs:    "1",
+			rhs:    "0",
+			result: "",
+			err:    ErrDivisionByZero,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(tc.lhs).DivExact(MustNewBigInt(tc.rhs))
+			if err != tc.err {
+				t.Fatalf("got %v, want %v", err, tc.err)
+			}
+
+			if got != nil && got.String() != tc.result {
+				t.Errorf("got %v, want %v", got.String(), tc.result)
+			}
+		})
+	}
+}
+
+func TestBigIntMod(t *testing.T) {
+	tests := []struct {
+		lhs    string
+		rhs    string
+		result string
+	}{
+		{
+			lhs:    "0",
+			rhs:    "7",
+			result: "0",
+		},
+		{
+			lhs:    "6",
+			rhs:    "7",
+			result: "6",
+		},
+		{
+			lhs:    "123",
+			rhs:    "10",
+			result: "3",
+		},
+		{
+			lhs:    "999999998000000001",
+			rhs:    "999999999",
+			result: "0",
+		},
+		{
+			lhs:    "123456789012345678901234567890",
+			rhs:    "987654321098765",
+			result: "547854957125085",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(tc.lhs).Mod(MustNewBigInt(tc.rhs))
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != tc.result {
+				t.Errorf("got %v, want %v", got.String(), tc.result)
+			}
+		})
+	}
+}
+
+func TestBigIntModByZero(t *testing.T) {
+	if _, err := MustNewBigInt("1").Mod(NewZero()); err != ErrDivisionByZero {
+		t.Errorf("got %v, want %v", err, ErrDivisionByZero)
+	}
+
+	if _, err := MustNewBigInt("1").ModUint32(0); err != ErrDivisionByZero {
+		t.Errorf("got %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestBigIntQuoRem(t *testing.T) {
+	random := rand.New(rand.NewSource(254))
+
+	sizes := []int{1, 9, 18, 19, 36, 37, 100, 400}
+
+	for idx := range 300 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		lhs := randomNumber(random, sizes[random.Intn(len(sizes))])
+		rhs := randomNumber(random, sizes[random.Intn(len(sizes))])
+
+		// INFO: the signs cover the four truncation cases
+		if idx%2 == 1 {
+			lhs = "-" + lhs
+		}
+
+		if idx%4 >= 2 {
+			rhs = "-" + rhs
+		}
+
+		chunkSize := 1 + random.Intn(maxChunkSize)
+
+		t.Run(testname, func(t *testing.T) {
+			lhsInt, _ := new(big.Int).SetString(lhs, 10)
+			rhsInt, _ := new(big.Int).SetString(rhs, 10)
+			wantQuotient, wantRemainder := new(big.Int).QuoRem(lhsInt, rhsInt, new(big.Int))
+
+			lhsValue := MustNewBigInt(lhs).inChunksOf(chunkSize)
+
+			quotient, remainder, err := lhsValue.QuoRem(MustNewBigInt(rhs))
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if quotient.String() != wantQuotient.String() || remainder.String() != wantRemainder.String() {
+				t.Errorf("%v / %v: got %v and %v, want %v and %v", lhs, rhs, quotient, remainder, wantQuotient, wantRemainder)
+			}
+
+			if got, _ := lhsValue.Quo(MustNewBigInt(rhs)); got.String() != wantQuotient.String() {
+				t.Errorf("Quo: got %v, want %v", got, wantQuotient)
+			}
+
+			if got, _ := lhsValue.Rem(MustNewBigInt(rhs)); got.String() != wantRemainder.String() {
+				t.Errorf("Rem: got %v, want %v", got, wantRemainder)
+			}
+		})
+	}
+}
+
+func TestBigIntQuoRemUnnormalizedChunks(t *testing.T) {
+	// INFO: chunks that don't fit in the chunk size, built by hand
+	value := &BigInt{magnitude: []uint64{math.MaxUint64, math.MaxUint64}, chukSize: maxChunkSize}
+
+	// The value is MaxUint64 * 10^18 + MaxUint64
+	decimal := new(big.Int).SetUint64(math.MaxUint64)
+	decimal.Add(decimal.Mul(decimal, new(big.Int).SetUint64(powersOfTen[maxChunkSize])), new(big.Int).SetUint64(math.MaxUint64))
+
+	divisor := MustNewBigInt("123456789012345678901")
+	divisorInt, _ := new(big.Int).SetString(divisor.String(), 10)
+	wantQuotient, wantRemainder := new(big.Int).QuoRem(decimal, divisorInt, new(big.Int))
+
+	quotient, remainder, err := value.QuoRem(divisor)
+	if err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+
+	if quotient.String() != wantQuotient.String() || remainder.String() != wantRemainder.String() {
+		t.Errorf("got %v and %v, want %v and %v", quotient, remainder, wantQuotient, wantRemainder)
+	}
+
+	// The unnormalized value divides itself exactly
+	if quotient, remainder, _ := value.QuoRem(value); quotient.String() != "1" || remainder.String() != "0" {
+		t.Errorf("got %v and %v, want 1 and 0", quotient, remainder)
+	}
+}
+
+func TestBigIntQuoRemByZero(t *testing.T) {
+	for _, divisor := range []*BigInt{NewZero(), MustNewBigInt("000"), {}, nil} {
+		if quotient, remainder, err := MustNewBigInt("1").QuoRem(divisor); err != ErrDivisionByZero || quotient != nil || remainder != nil {
+			t.Errorf("got %v, %v, %v, want nil, nil, %v", quotient, remainder, err, ErrDivisionByZero)
+		}
+
+		if _, err := MustNewBigInt("1").Quo(divisor); err != ErrDivisionByZero {
+			t.Errorf("got %v, want %v", err, ErrDivisionByZero)
+		}
+
+		if _, err := MustNewBigInt("1").Rem(divisor); err != ErrDivisionByZero {
+			t.Errorf("got %v, want %v", err, ErrDivisionByZero)
+		}
+	}
+}
+
+func TestBigIntEuclideanMod(t *testing.T) {
+	tests := []struct {
+		lhs  string
+		rhs  string
+		want string
+	}{
+		{
+			lhs:  "0",
+			rhs:  "7",
+			want: "0",
+		},
+		{
+			lhs:  "17",
+			rhs:  "5",
+			want: "2",
+		},
+		{
+			lhs:  "5",
+			rhs:  "17",
+			want: "5",
+		},
+		{
+			lhs:  "123456789012345678901234567890",
+			rhs:  "1000000000000000000",
+			want: "345678901234567890",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(tc.lhs).EuclideanMod(MustNewBigInt(tc.rhs))
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+
+	if _, err := MustNewBigInt("1").EuclideanMod(nil); err != ErrDivisionByZero {
+		t.Errorf("got %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestBigIntEuclideanModSigned(t *testing.T) {
+	tests := []struct {
+		lhs       string
+		rhs       string
+		mod       string
+		euclidean string
+	}{
+		{
+			lhs:       "7",
+			rhs:       "3",
+			mod:       "1",
+			euclidean: "1",
+		},
+		{
+			lhs:       "-7",
+			rhs:       "3",
+			mod:       "-1",
+			euclidean: "2",
+		},
+		{
+			lhs:       "7",
+			rhs:       "-3",
+			mod:       "1",
+			euclidean: "1",
+		},
+		{
+			lhs:       "-7",
+			rhs:       "-3",
+			mod:       "-1",
+			euclidean: "2",
+		},
+		{
+			lhs:       "-6",
+			rhs:       "3",
+			mod:       "0",
+			euclidean: "0",
+		},
+		{
+			lhs:       "-1",
+			rhs:       "1000000000000000000000",
+			mod:       "-1",
+			euclidean: "999999999999999999999",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			lhs, rhs := MustNewBigInt(tc.lhs), MustNewBigInt(tc.rhs)
+
+			if got, _ := lhs.Mod(rhs); got.String() != tc.mod {
+				t.Errorf("got %v, want %v", got.String(), tc.mod)
+			}
+
+			if got, _ := lhs.EuclideanMod(rhs); got.String() != tc.euclidean {
+				t.Errorf("got %v, want %v", got.String(), tc.euclidean)
+			}
+		})
+	}
+}
+
+func TestBigIntModUint32(t *testing.T) {
+	random := rand.New(rand.NewSource(157))
+
+	moduli := []uint32{1, 2, 3, 7, 10, 97, 999999999, 1000000000, math.MaxUint32}
+
+	for idx, modulus := range moduli {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			for range 20 {
+				digits := randomNumber(random, 1+random.Intn(100))
+
+				// INFO: the negative values must match the Euclidean remainder
+				if random.Intn(2) == 0 {
+					digits = "-" + digits
+				}
+
+				value := MustNewBigInt(digits)
+
+				got, err := value.ModUint32(modulus)
+				if err != nil {
+					t.Fatalf("got %v, want nil", err)
+				}
+
+				want, _ := value.EuclideanMod(MustNewBigInt(strconv.FormatUint(uint64(modulus), 10)))
+
+				if strconv.FormatUint(uint64(got), 10) != want.String() {
+					t.Errorf("%v mod %v: got %v, want %v", value, modulus, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBigIntMulCarries(t *testing.T) {
+	tests := []struct {
+		lhs string
+		rhs string
+	}{
+		{
+			lhs: strings.Repeat("9", 50),
+			rhs: strings.Repeat("9", 50),
+		},
+		{
+			lhs: strings.Repeat("9", 37),
+			rhs: "9",
+		},
+		{
+			lhs: "1" + strings.Repeat("0", 40) + "1",
+			rhs: strings.Repeat("9", 42),
+		},
+		{
+			lhs: strings.Repeat("9", 200),
+			rhs: strings.Repeat("9", 150),
+		},
+	}
+
+	for idx, tc := range tests {
+		lhs, _ := new(big.Int).SetString(tc.lhs, 10)
+		rhs, _ := new(big.Int).SetString(tc.rhs, 10)
+		want := new(big.Int).Mul(lhs, rhs).String()
+
+		// INFO: every chunk size puts the carries on different chunk boundaries
+		for chunkSize := 1; chunkSize <= maxChunkSize; chunkSize++ {
+			testname := fmt.Sprintf("test#%d/chunk#%d", idx, chunkSize)
+
+			t.Run(testname, func(t *testing.T) {
+				got := MustNewBigInt(tc.lhs, WithChunkSize(chunkSize)).Mul(MustNewBigInt(tc.rhs))
+				if got.String() != want {
+					t.Errorf("got %v, want %v", got.String(), want)
+				}
+			})
+		}
+	}
+}
+
+func TestBigIntMulUnnormalizedChunks(t *testing.T) {
+	// INFO: chunks that don't fit in the chunk size, built by hand
+	value := &BigInt{magnitude: []uint64{math.MaxUint64, math.MaxUint64}, chukSize: maxChunkSize}
+
+	// The value is MaxUint64 * 10^18 + MaxUint64
+	decimal := new(big.Int).SetUint64(math.MaxUint64)
+	decimal.Add(decimal.Mul(decimal, new(big.Int).SetUint64(powersOfTen[maxChunkSize])), new(big.Int).SetUint64(math.MaxUint64))
+
+	square := new(big.Int).Mul(decimal, decimal)
+
+	if got := value.Mul(value); got.String() != square.String() {
+		t.Errorf("got %v, want %v", got.String(), square.String())
+	}
+
+	if got := value.MulAdd(value, value); got.String() != new(big.Int).Add(square, decimal).String() {
+		t.Errorf("got %v, want %v", got.String(), new(big.Int).Add(square, decimal).String())
+	}
+
+	scaled := new(big.Int).Mul(decimal, new(big.Int).SetUint64(math.MaxUint64))
+
+	if got := value.MulScalar(math.MaxUint64); got.String() != scaled.String() {
+		t.Errorf("got %v, want %v", got.String(), scaled.String())
+	}
+}
+
+func TestBigIntMulAdd(t *testing.T) {
+	tests := []struct {
+		value  string
+		factor string
+		addend string
+		result string
+	}{
+		{
+			value:  "0",
+			factor: "123",
+			addend: "0",
+			result: "0",
+		},
+		{
+			value:  "0",
+			factor: "0",
+			addend: "123456789012345678901234567890",
+			result: "123456789012345678901234567890",
+		},
+		{
+			value:  "12",
+			factor: "10",
+			addend: "3",
+			result: "123",
+		},
+		{
+			value:  "999999999",
+			factor: "999999999",
+			addend: "1999999998",
+			result: "999999999999999999",
+		},
+		{
+			value:  "999999999999999999",
+			factor: "1",
+			addend: "1",
+			result: "1000000000000000000",
+		},
+		{
+			value:  "123456789012345678901",
+			factor: "12345678",
+			addend: "000000000000000000000000000000000000000001",
+			result: "1524157764060357776403139879",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value, factor, addend := MustNewBigInt(tc.value), MustNewBigInt(tc.factor), MustNewBigInt(tc.addend)
+
+			got := value.MulAdd(factor, addend)
+			if got.String() != tc.result {
+				t.Errorf("got %v, want %v", got.String(), tc.result)
+			}
+
+			// MulAdd must match the separate operations
+			if want := value.Mul(factor).Add(addend); got.Cmp(want) != 0 {
+				t.Errorf("got %v, want %v", got.String(), want.String())
+			}
+		})
+	}
+}
+
+func TestBigIntMulScalar(t *testing.T) {
+	tests := []struct {
+		input  string
+		factor uint64
+		result string
+	}{
+		{
+			input:  "123456789012345678901",
+			factor: 0,
+			result: "0",
+		},
+		{
+			input:  "999999999",
+			factor: 3,
+			result: "2999999997",
+		},
+		{
+			input:  "123456789012345678901",
+			factor: 18446744073709551615,
+			result: "2277375791072698140120607035175475975115",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := MustNewBigInt(tc.input).MulScalar(tc.factor); got.String() != tc.result {
+				t.Errorf("got %v, want %v", got.String(), tc.result)
+			}
+		})
+	}
+}
+
+func TestBigIntIsEven(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{
+			input: "0",
+			want:  true,
+		},
+		{
+			input: "7",
+			want:  false,
+		},
+		{
+			input: "1000000000",
+			want:  true,
+		},
+		{
+			input: "123456789012345678901",
+			want:  false,
+		},
+		{
+			input: "-8",
+			want:  true,
+		},
+		{
+			input: "-123456789012345678901",
+			want:  false,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := MustNewBigInt(tc.input).IsEven(); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+
+			if got := MustNewBigInt(tc.input).IsOdd(); got == tc.want {
+				t.Errorf("got %v, want %v", got, !tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntSign(t *testing.T) {
+	tests := []struct {
+		value *BigInt
+		want  int
+	}{
+		{
+			value: MustNewBigInt("0"),
+			want:  0,
+		},
+		{
+			value: MustNewBigInt("-000"),
+			want:  0,
+		},
+		{
+			value: nil,
+			want:  0,
+		},
+		{
+			value: &BigInt{},
+			want:  0,
+		},
+		{
+			// INFO: leading zero chunks, built by hand
+			value: &BigInt{magnitude: []uint64{0, 0}, chukSize: maxChunkSize},
+			want:  0,
+		},
+		{
+			value: MustNewBigInt("42"),
+			want:  1,
+		},
+		{
+			value: MustNewBigInt("-42"),
+			want:  -1,
+		},
+		{
+			value: MustNewBigInt("-123456789012345678901234567890"),
+			want:  -1,
+		},
+		{
+			value: MustNewBigInt("1").Sub(MustNewBigInt("1")),
+			want:  0,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := tc.value.Sign(); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+
+			if got := tc.value.IsZero(); got != (tc.want == 0) {
+				t.Errorf("got %v, want %v", got, tc.want == 0)
+			}
+		})
+	}
+}
+
+func TestBigIntIsOne(t *testing.T) {
+	tests := []struct {
+		value *BigInt
+		want  bool
+	}{
+		{
+			value: MustNewBigInt("1"),
+			want:  true,
+		},
+		{
+			value: MustNewBigInt("000000000000000001"),
+			want:  true,
+		},
+		{
+			value: NewOne(),
+			want:  true,
+		},
+		{
+			value: MustNewBigInt("0"),
+			want:  false,
+		},
+		{
+			value: nil,
+			want:  false,
+		},
+		{
+			value: MustNewBigInt("10"),
+			want:  false,
+		},
+		{
+			value: MustNewBigInt("1000000001"),
+			want:  false,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := tc.value.IsOne(); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntIncAndHalf(t *testing.T) {
+	tests := []struct {
+		input string
+		inc   string
+		half  string
+	}{
+		{
+			input: "0",
+			inc:   "1",
+			half:  "0",
+		},
+		{
+			input: "1",
+			inc:   "2",
+			half:  "0",
+		},
+		{
+			input: "999999999",
+			inc:   "1000000000",
+			half:  "499999999",
+		},
+		{
+			input: "1000000000000000000",
+			inc:   "1000000000000000001",
+			half:  "500000000000000000",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := MustNewBigInt(tc.input).Inc(); got.String() != tc.inc {
+				t.Errorf("got %v, want %v", got.String(), tc.inc)
+			}
+
+			if got := MustNewBigInt(tc.input).Half(); got.String() != tc.half {
+				t.Errorf("got %v, want %v", got.String(), tc.half)
+			}
+		})
+	}
+}
+
+func TestPowersOfTen(t *testing.T) {
+	want := uint64(1)
+
+	for idx, got := range powersOfTen {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if uint64(got) != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+
+		want *= 10
+	}
+}
+
+func TestZeroAndOne(t *testing.T) {
+	tests := []struct {
+		value *BigInt
+		want  string
+	}{
+		{
+			value: Zero,
+			want:  "0",
+		},
+		{
+			value: One,
+			want:  "1",
+		},
+		{
+			value: NewZero(),
+			want:  "0",
+		},
+		{
+			value: NewOne(),
+			want:  "1",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if tc.value.String() != tc.want {
+				t.Errorf("got %v, want %v", tc.value.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestZeroAndOneAreNotAliased(t *testing.T) {
+	sum := Zero.Add(One)
+	sum.magnitude[0] = 42
+
+	if Zero.String() != "0" {
+		t.Errorf("got %v, want %v", Zero.String(), "0")
+	}
+
+	if One.String() != "1" {
+		t.Errorf("got %v, want %v", One.String(), "1")
+	}
+
+	fresh := NewOne()
+	fresh.magnitude[0] = 42
+
+	if One.String() != "1" {
+		t.Errorf("got %v, want %v", One.String(), "1")
+	}
+}
+
+func TestZeroAndOneAreFrozen(t *testing.T) {
+	tests := []struct {
+		value  *BigInt
+		mutate func(b *BigInt) error
+		want   string
+	}{
+		{
+			value: Zero,
+			mutate: func(b *BigInt) error {
+				return b.UnmarshalText([]byte("5"))
+			},
+			want: "0",
+		},
+		{
+			value: One,
+			mutate: func(b *BigInt) error {
+				return b.UnmarshalBinary([]byte{0, 0, 0, 5})
+			},
+			want: "1",
+		},
+		{
+			value: One,
+			mutate: func(b *BigInt) error {
+				_, err := fmt.Sscan("5", b)
+
+				return err
+			},
+			want: "1",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if err := tc.mutate(tc.value); err != ErrFrozen {
+				t.Errorf("got %v, want %v", err, ErrFrozen)
+			}
+
+			if tc.value.String() != tc.want {
+				t.Errorf("got %v, want %v", tc.value.String(), tc.want)
+			}
+		})
+	}
+
+	// Values derived from the frozen ones are regular values
+	sum := One.Add(One)
+	if err := sum.UnmarshalText([]byte("5")); err != nil {
+		t.Errorf("got %v, want nil", err)
+	}
+}
+
+func TestBigIntSetString(t *testing.T) {
+	tests := []struct {
+		initial string
+		input   string
+		want    string
+		err     error
+	}{
+		{
+			initial: "123456789012345678901234567890",
+			input:   "42",
+			want:    "42",
+			err:     nil,
+		},
+		{
+			initial: "42",
+			input:   "123456789012345678901234567890",
+			want:    "123456789012345678901234567890",
+			err:     nil,
+		},
+		{
+			initial: "42",
+			input:   " 007.00\n",
+			want:    "7",
+			err:     nil,
+		},
+		{
+			initial: "123456789012345678901234567890",
+			input:   "1234567890123456789x",
+			want:    "123456789012345678901234567890",
+			err:     ErrConvertingChunkToInteger,
+		},
+		{
+			initial: "42",
+			input:   "",
+			want:    "42",
+			err:     ErrConvertingChunkToInteger,
+		},
+		{
+			initial: "42",
+			input:   "4.2",
+			want:    "42",
+			err:     ErrInvalidIntegerNumber,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			bg := MustNewBigInt(tc.initial)
+
+			if err := bg.SetString(tc.input); err != tc.err {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+
+			if bg.String() != tc.want {
+				t.Errorf("got %v, want %v", bg.String(), tc.want)
+			}
+
+			if bg.Length() != len(tc.want) {
+				t.Errorf("got %v, want %v", bg.Length(), len(tc.want))
+			}
+		})
+	}
+}
+
+func TestBigIntSetStringFrozen(t *testing.T) {
+	if err := One.SetString("2"); err != ErrFrozen {
+		t.Errorf("got %v, want %v", err, ErrFrozen)
+	}
+
+	if got := One.String(); got != "1" {
+		t.Errorf("got %v, want %v", got, "1")
+	}
+}
+
+func TestMustNewBigInt(t *testing.T) {
+	got := MustNewBigInt("123456789012")
+
+	if got.String() != "123456789012" {
+		t.Errorf("got %v, want %v", got.String(), "123456789012")
+	}
+}
+
+func TestMustNewBigIntPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("got no panic, want panic")
+		}
+	}()
+
+	MustNewBigInt("abc")
+}
+
+func TestBigIntString(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: "0",
+			want:  "0",
+		},
+		{
+			input: "000",
+			want:  "0",
+		},
+		{
+			input: "1000000000",
+			want:  "1000000000",
+		},
+		{
+			input: "1000000000000000001",
+			want:  "1000000000000000001",
+		},
+		{
+			input: "000000000001",
+			want:  "1",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			bg, _ := NewBigInt(tc.input)
+
+			if bg.String() != tc.want {
+				t.Errorf("got %v, want %v", bg.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntToDecimalBytes(t *testing.T) {
+	tests := []*BigInt{
+		MustNewBigInt("0"),
+		MustNewBigInt("123"),
+		MustNewBigInt("1000000001"),
+		MustNewBigInt("000000000000000000123"),
+		MustNewBigInt("123456789000000000000000001"),
+		{},
+		nil,
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got, want := string(tc.ToDecimalBytes()), tc.String(); got != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBigIntAppendString(t *testing.T) {
+	tests := []*BigInt{
+		MustNewBigInt("0"),
+		MustNewBigInt("123"),
+		MustNewBigInt("1000000001"),
+		MustNewBigInt("000000000000000000123"),
+		MustNewBigInt("123456789000000000000000001"),
+		MustNewBigInt("1000000000000000000000000000000000000"),
+		MustNewBigInt("1000200030004", WithChunkSize(3)),
+		{},
+		nil,
+	}
+
+	buffer := []byte("values:")
+	want := "values:"
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := string(tc.AppendString(nil)); got != tc.String() {
+				t.Errorf("got %v, want %v", got, tc.String())
+			}
+
+			buffer = tc.AppendString(append(buffer, ' '))
+			want += " " + tc.String()
+
+			if string(buffer) != want {
+				t.Errorf("got %v, want %v", string(buffer), want)
+			}
+		})
+	}
+}
+
+func TestBigIntZeroValueString(t *testing.T) {
+	var bg BigInt
+
+	if got := bg.String(); got != "0" {
+		t.Errorf("got %v, want %v", got, "0")
+	}
+
+	sum := bg.Add(MustNewBigInt("1000000000000"))
+	if got := sum.String(); got != "1000000000000" {
+		t.Errorf("got %v, want %v", got, "1000000000000")
+	}
+}
+
+func TestBigIntZeroValue(t *testing.T) {
+	var bg BigInt
+
+	if got := bg.Length(); got != 1 {
+		t.Errorf("got %v, want %v", got, 1)
+	}
+
+	if got := bg.Cmp(NewZero()); got != 0 {
+		t.Errorf("got %v, want %v", got, 0)
+	}
+
+	if got := NewZero().Cmp(&bg); got != 0 {
+		t.Errorf("got %v, want %v", got, 0)
+	}
+
+	if got := bg.Cmp(MustNewBigInt("1")); got != -1 {
+		t.Errorf("got %v, want %v", got, -1)
+	}
+
+	if got := bg.Add(&BigInt{}); got.String() != "0" || got.Length() != 1 {
+		t.Errorf("got %v, want %v", got.String(), "0")
+	}
+
+	if got := bg.Mul(MustNewBigInt("123")); got.String() != "0" {
+		t.Errorf("got %v, want %v", got.String(), "0")
+	}
+
+	if got := bg.Inc(); got.String() != "1" {
+		t.Errorf("got %v, want %v", got.String(), "1")
+	}
+
+	if got := bg.ShiftLeft(10); got.String() != "0" {
+		t.Errorf("got %v, want %v", got.String(), "0")
+	}
+
+	if got := bg.Text(2); got != "0" {
+		t.Errorf("got %v, want %v", got, "0")
+	}
+
+	if got, _ := bg.ToUint64(); got != 0 {
+		t.Errorf("got %v, want %v", got, 0)
+	}
+
+	if got, _ := bg.MarshalText(); string(got) != "0" {
+		t.Errorf("got %v, want %v", string(got), "0")
+	}
+
+	if err := bg.AddInPlace(MustNewBigInt("999999999999")); err != nil || bg.String() != "999999999999" {
+		t.Errorf("got %v, want %v", bg.String(), "999999999999")
+	}
+
+	var other BigInt
+
+	if err := other.SetString("42"); err != nil || other.String() != "42" {
+		t.Errorf("got %v, want %v", other.String(), "42")
+	}
+}
+
+func TestBigIntGoString(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: "123",
+			want:  `bignumber.MustNewBigInt("123")`,
+		},
+		{
+			input: "1000000000000000001",
+			want:  `bignumber.MustNewBigInt("1000000000000000001")`,
+		},
+		{
+			// INFO: NewBigInt accepts the sign, so the negative values can be pasted back too
+			input: "-42",
+			want:  `bignumber.MustNewBigInt("-42")`,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			bg := MustNewBigInt(tc.input)
+
+			got := fmt.Sprintf("%#v", bg)
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+
+			if bg.String() != tc.input {
+				t.Errorf("got %v, want %v", bg.String(), tc.input)
+			}
+		})
+	}
+}
+
+func TestBigIntKey(t *testing.T) {
+	tests := []struct {
+		lhs *BigInt
+		rhs *BigInt
+	}{
+		{
+			lhs: MustNewBigInt("007"),
+			rhs: MustNewBigInt("7"),
+		},
+		{
+			lhs: MustNewBigInt("1234"),
+			rhs: &BigInt{magnitude: []uint64{1, 234}, chukSize: 3},
+		},
+		{
+			lhs: nil,
+			rhs: MustNewBigInt("000"),
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if tc.lhs.Key() != tc.rhs.Key() {
+				t.Errorf("got %v, want %v", tc.lhs.Key(), tc.rhs.Key())
+			}
+		})
+	}
+}
+
+func TestBigIntAddNil(t *testing.T) {
+	bg := MustNewBigInt("1234567890123")
+
+	got := bg.Add(nil)
+	if got.String() != bg.String() {
+		t.Errorf("got %v, want %v", got.String(), bg.String())
+	}
+
+	// The result must not share the magnitude with the receiver
+	got.magnitude[0] = 42
+
+	if bg.String() != "1234567890123" {
+		t.Errorf("got %v, want %v", bg.String(), "1234567890123")
+	}
+}
+
+func TestBigIntNilReceiver(t *testing.T) {
+	var bg *BigInt
+
+	if got := bg.String(); got != "0" {
+		t.Errorf("got %v, want %v", got, "0")
+	}
+
+	if got := bg.Add(MustNewBigInt("123")); got.String() != "123" {
+		t.Errorf("got %v, want %v", got.String(), "123")
+	}
+
+	if got := bg.Cmp(NewZero()); got != 0 {
+		t.Errorf("got %v, want %v", got, 0)
+	}
+
+	if got := MustNewBigInt("0").Between(nil, MustNewBigInt("10"), true); !got {
+		t.Errorf("got %v, want %v", got, true)
+	}
+
+	if got := MustNewBigInt("0").Between(MustNewBigInt("0"), nil, true); !got {
+		t.Errorf("got %v, want %v", got, true)
+	}
+}
+
+func TestBigIntNilReceiverSetPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("got no panic, want panic")
+		}
+	}()
+
+	var bg *BigInt
+
+	_ = bg.UnmarshalText([]byte("123"))
+}
+
+func TestBigIntNormalize(t *testing.T) {
+	tests := []struct {
+		value     *BigInt
+		want      string
+		magnitude []uint64
+	}{
+		{
+			value:     &BigInt{magnitude: []uint64{0, 0, 5}},
+			want:      "5",
+			magnitude: []uint64{5},
+		},
+		{
+			value:     &BigInt{},
+			want:      "0",
+			magnitude: []uint64{0},
+		},
+		{
+			value:     &BigInt{magnitude: []uint64{0, 0, 0}},
+			want:      "0",
+			magnitude: []uint64{0},
+		},
+		{
+			value:     &BigInt{magnitude: []uint64{1, 1000000000000000000}},
+			want:      "2000000000000000000",
+			magnitude: []uint64{2, 0},
+		},
+		{
+			value:     MustNewBigInt("0000000000000000001234567890123456789012"),
+			want:      "1234567890123456789012",
+			magnitude: []uint64{1234, 567890123456789012},
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			tc.value.Normalize()
+
+			if got := tc.value.String(); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+
+			if got := tc.value.Magnitude(); !slices.Equal(got, tc.magnitude) {
+				t.Errorf("got %v, want %v", got, tc.magnitude)
+			}
+
+			if got := tc.value.Length(); got != len(tc.want) {
+				t.Errorf("got %v, want %v", got, len(tc.want))
+			}
+		})
+	}
+}
+
+func TestBigIntUnnormalizedChunks(t *testing.T) {
+	// INFO: chunks that don't fit in the chunk size, built by hand, every
+	// reader must see the value they carry into
+	tests := []struct {
+		value *BigInt
+		want  string
+	}{
+		{
+			value: &BigInt{magnitude: []uint64{1, math.MaxUint64}},
+			want:  "19446744073709551615",
+		},
+		{
+			value: &BigInt{magnitude: []uint64{0, 1000000000000000000}},
+			want:  "1000000000000000000",
+		},
+		{
+			value: &BigInt{magnitude: []uint64{math.MaxUint64}, negative: true},
+			want:  "-18446744073709551615",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			want, _ := new(big.Int).SetString(tc.want, 10)
+
+			if got := tc.value.String(); got != tc.want {
+				t.Errorf("String: got %v, want %v", got, tc.want)
+			}
+
+			if got := tc.value.Text(16); got != want.Text(16) {
+				t.Errorf("Text: got %v, want %v", got, want.Text(16))
+			}
+
+			if got := tc.value.Length(); got != len(strings.TrimPrefix(tc.want, "-")) {
+				t.Errorf("Length: got %v, want %v", got, len(strings.TrimPrefix(tc.want, "-")))
+			}
+
+			if got := tc.value.BitLen(); got != want.BitLen() {
+				t.Errorf("BitLen: got %v, want %v", got, want.BitLen())
+			}
+
+			if got, want := tc.value.Half().String(), new(big.Int).Quo(want, big.NewInt(2)).String(); got != want {
+				t.Errorf("Half: got %v, want %v", got, want)
+			}
+
+			quotient, err := tc.value.DivExact(MustNewBigInt("5"))
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got, want := quotient.String(), new(big.Int).Quo(want, big.NewInt(5)).String(); got != want {
+				t.Errorf("DivExact: got %v, want %v", got, want)
+			}
+
+			if got, _ := tc.value.ModUint32(7); uint64(got) != new(big.Int).Mod(want, big.NewInt(7)).Uint64() {
+				t.Errorf("ModUint32: got %v, want %v", got, new(big.Int).Mod(want, big.NewInt(7)))
+			}
+
+			if got, err := tc.value.ToUint64(); want.IsUint64() && (err != nil || got != want.Uint64()) {
+				t.Errorf("ToUint64: got %v, %v, want %v", got, err, want.Uint64())
+			}
+
+			if got := tc.value.CmpInt64(math.MaxInt64); got != want.Cmp(big.NewInt(math.MaxInt64)) {
+				t.Errorf("CmpInt64: got %v, want %v", got, want.Cmp(big.NewInt(math.MaxInt64)))
+			}
+
+			if got := tc.value.IsOne(); got {
+				t.Errorf("IsOne: got %v, want %v", got, false)
+			}
+
+			if err := tc.value.AddInPlace(MustNewBigInt("1")); err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got, want := tc.value.String(), new(big.Int).Add(want, big.NewInt(1)).String(); got != want {
+				t.Errorf("AddInPlace: got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBigIntNumChunks(t *testing.T) {
+	tests := []struct {
+		value *BigInt
+		want  int
+	}{
+		{
+			value: &BigInt{},
+			want:  0,
+		},
+		{
+			value: MustNewBigInt("0"),
+			want:  1,
+		},
+		{
+			value: MustNewBigInt("123456789012345678"),
+			want:  1,
+		},
+		{
+			value: MustNewBigInt("1234567890123456789"),
+			want:  2,
+		},
+		{
+			value: MustNewBigInt("000000000000000000000000000000000000123"),
+			want:  3,
+		},
+		{
+			value: MustNewBigInt("000000000000000000000000000000000000123").Add(NewZero()),
+			want:  1,
+		},
+		{
+			value: MustNewBigInt("999999999999999999").Add(MustNewBigInt("1")),
+			want:  2,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := tc.value.NumChunks(); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntMagnitude(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []uint64
+	}{
+		{
+			value: "0",
+			want:  []uint64{0},
+		},
+		{
+			value: "1234567890",
+			want:  []uint64{1234567890},
+		},
+		{
+			value: "1234567890123456789",
+			want:  []uint64{1, 234567890123456789},
+		},
+		{
+			value: "123456789012345678901234567890",
+			want:  []uint64{123456789012, 345678901234567890},
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := MustNewBigInt(tc.value).Magnitude(); !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntMagnitudeIsACopy(t *testing.T) {
+	bg := MustNewBigInt("1234567890")
+
+	magnitude := bg.Magnitude()
+	magnitude[0] = 9
+
+	if got := bg.String(); got != "1234567890" {
+		t.Errorf("got %v, want %v", got, "1234567890")
+	}
+}
+
+func TestBigIntLog10(t *testing.T) {
+	tests := []struct {
+		value *BigInt
+		want  int
+	}{
+		{
+			value: MustNewBigInt("1"),
+			want:  0,
+		},
+		{
+			value: MustNewBigInt("9"),
+			want:  0,
+		},
+		{
+			value: MustNewBigInt("10"),
+			want:  1,
+		},
+		{
+			value: MustNewBigInt("007"),
+			want:  0,
+		},
+		{
+			value: MustNewBigInt("000000000000000000123"),
+			want:  2,
+		},
+		{
+			value: MustNewBigInt("999999999"),
+			want:  8,
+		},
+		{
+			value: MustNewBigInt("999999999").Add(MustNewBigInt("1")),
+			want:  9,
+		},
+		{
+			value: MustNewBigInt("999999999999999999").Add(MustNewBigInt("999999999999999999")),
+			want:  18,
+		},
+		{
+			value: MustNewBigInt("10").Pow(30),
+			want:  30,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := tc.value.Log10()
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBigIntLog10Zero(t *testing.T) {
+	for _, value := range []*BigInt{MustNewBigInt("0"), MustNewBigInt("000"), {}, nil} {
+		if _, err := value.Log10(); err != ErrLogOfZero {
+			t.Errorf("got %v, want %v", err, ErrLogOfZero)
+		}
+	}
+}
+
+func BenchmarkBigIntAdd(b *testing.B) {
+	lhs := MustNewBigInt(strings.Repeat("9", 1000))
+	rhs := MustNewBigInt(strings.Repeat("1234567890", 100))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = lhs.Add(rhs)
+	}
+}
+
+func BenchmarkBigIntSub(b *testing.B) {
+	total := MustNewBigInt(strings.Repeat("9", 1000))
+	value := MustNewBigInt("123456789")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		total = total.Sub(value)
+	}
+}
+
+func BenchmarkBigIntSubInPlace(b *testing.B) {
+	total := MustNewBigInt(strings.Repeat("9", 1000))
+	value := MustNewBigInt("123456789")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = total.SubInPlace(value)
+	}
+}
+
+// hornerCoefficients and hornerPoint are the polynomial evaluated by the Horner benchmarks.
+var (
+	hornerCoefficients = []*BigInt{
+		MustNewBigInt("123456789012345678901234567890"),
+		MustNewBigInt("987654321098765432109876543210"),
+		MustNewBigInt("555555555555555555555555555555"),
+		MustNewBigInt("1"),
+		MustNewBigInt("42"),
+	}
+	hornerPoint = MustNewBigInt("1000000007")
+)
+
+func BenchmarkBigIntHornerMulAdd(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		acc := NewZero()
+
+		for _, coefficient := range hornerCoefficients {
+			acc = acc.MulAdd(hornerPoint, coefficient)
+		}
+	}
+}
+
+func BenchmarkBigIntHornerMulThenAdd(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		acc := NewZero()
+
+		for _, coefficient := range hornerCoefficients {
+			acc = acc.Mul(hornerPoint).Add(coefficient)
+		}
+	}
+}
+
+func BenchmarkNewBigInt(b *testing.B) {
+	value := strings.Repeat("1234567890", 10)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = NewBigInt(value)
+	}
+}
+
+func BenchmarkBigIntSetString(b *testing.B) {
+	value := strings.Repeat("1234567890", 10)
+	bg := NewZero()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = bg.SetString(value)
+	}
+}
+
+func BenchmarkBigIntString(b *testing.B) {
+	bg := MustNewBigInt(strings.Repeat("1234567890", 1000))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = bg.String()
+	}
+}
+
+// appendStringValues are the values formatted by the AppendString benchmarks.
+func appendStringValues() []*BigInt {
+	values := make([]*BigInt, 100)
+	for idx := range values {
+		values[idx] = MustNewBigInt(fmt.Sprintf("%d000000000000000000123456789012345678901234567890", idx))
+	}
+
+	return values
+}
+
+func BenchmarkBigIntAppendString(b *testing.B) {
+	values := appendStringValues()
+	buffer := make([]byte, 0, 64*len(values))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		buffer = buffer[:0]
+
+		for _, value := range values {
+			buffer = value.AppendString(buffer)
+		}
+	}
+}
+
+func BenchmarkBigIntConcatString(b *testing.B) {
+	values := appendStringValues()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var text string
+
+		for _, value := range values {
+			text += value.String()
+		}
+
+		_ = text
+	}
+}
@@ -0,0 +1,79 @@
+package bignumber
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// minBase is the smallest base supported by Text.
+	minBase = 2
+	// maxBase is the largest base supported by Text.
+	maxBase = 36
+)
+
+// Text returns the string representation of the BigInt in the given base
+// using lowercase letters for the digits >= 10, just like `big.Int.Text`.
+// The base must be between 2 and 36, otherwise an empty string is returned.
+// The negative values start with a '-' sign in every base.
+func (b *BigInt) Text(base int) string {
+	b = b.orZero()
+
+	if base < minBase || base > maxBase {
+		return ""
+	}
+
+	if base == 10 {
+		return b.String()
+	}
+
+	// Compute the biggest power of the base that fits in a uint32, this
+	// allows us to extract several digits on each division
+	divisor, digitsPerDivision := uint64(base), 1
+	for divisor*uint64(base) <= uint64(^uint32(0)) {
+		divisor *= uint64(base)
+		digitsPerDivision++
+	}
+
+	// Work on a copy since the division is done in place
+	normalized := normalizeMagnitude(b.magnitude, b.base())
+	magnitude := make([]uint64, len(normalized))
+	copy(magnitude, normalized)
+
+	chunkBase := b.base()
+
+	// Extract the digits from the least significant to the most significant
+	var groups []string
+
+	for !isZeroMagnitude(magnitude) {
+		remainder := divModUint64(magnitude, magnitude, chunkBase, divisor)
+		magnitude = trimLeadingZeroChunks(magnitude)
+
+		groups = append(groups, strconv.FormatUint(remainder, base))
+	}
+
+	if len(groups) == 0 {
+		return "0"
+	}
+
+	var result strings.Builder
+
+	result.Grow(1 + len(groups)*digitsPerDivision)
+
+	if b.negative {
+		result.WriteByte('-')
+	}
+
+	for idx := len(groups) - 1; idx >= 0; idx-- {
+		group := groups[idx]
+
+		// Every group but the most significant one must be padded with zeros
+		if idx < len(groups)-1 {
+			result.WriteString(strings.Repeat("0", digitsPerDivision-len(group)))
+		}
+
+		result.WriteString(group)
+	}
+
+	return result.String()
+}
@@ -0,0 +1,56 @@
+package bignumber
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestBigIntText(t *testing.T) {
+	inputs := []string{
+		"0",
+		"1",
+		"35",
+		"255",
+		"1000000000",
+		"4294967295",
+		"4294967296",
+		"18446744073709551616",
+		"123456789012345678901234567890",
+		"340282366920938463463374607431768211455",
+		"-1",
+		"-255",
+		"-123456789012345678901234567890",
+	}
+
+	bases := []int{2, 3, 8, 10, 16, 36}
+
+	for idx, input := range inputs {
+		for _, base := range bases {
+			testname := fmt.Sprintf("test#%d-base%d", idx, base)
+
+			t.Run(testname, func(t *testing.T) {
+				bg, _ := NewBigInt(input)
+				want, _ := new(big.Int).SetString(input, 10)
+
+				if got := bg.Text(base); got != want.Text(base) {
+					t.Errorf("got %v, want %v", got, want.Text(base))
+				}
+			})
+		}
+	}
+}
+
+func TestBigIntTextInvalidBase(t *testing.T) {
+	bg := MustNewBigInt("123")
+
+	for idx, base := range []int{-1, 0, 1, 37} {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := bg.Text(base); got != "" {
+				t.Errorf("got %v, want %v", got, "")
+			}
+		})
+	}
+}
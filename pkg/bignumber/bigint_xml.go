@@ -0,0 +1,37 @@
+package bignumber
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// MarshalXML implements the xml.Marshaler interface
+// encoding the BigInt as the element text content.
+func (b *BigInt) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return encoder.EncodeElement(b.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface
+// decoding the BigInt from the element text content.
+// An empty element returns ErrEmptyNumber.
+func (b *BigInt) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	var text string
+
+	if err := decoder.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+
+	text = strings.TrimSpace(text)
+
+	if text == "" {
+		return fmt.Errorf("decoding <%s>: %w", start.Name.Local, ErrEmptyNumber)
+	}
+
+	bigInt, err := NewBigInt(text)
+	if err != nil {
+		return fmt.Errorf("decoding <%s> with content %q: %w", start.Name.Local, text, err)
+	}
+
+	return b.set(bigInt)
+}
@@ -0,0 +1,80 @@
+package bignumber
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type xmlPayload struct {
+	XMLName xml.Name `xml:"payload"`
+	Value   *BigInt  `xml:"value"`
+}
+
+func TestBigIntXMLRoundTrip(t *testing.T) {
+	inputs := []string{
+		"0",
+		"1000000000",
+		"123456789012345678901234567890",
+		"-123456789012345678901234567890",
+	}
+
+	for idx, input := range inputs {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			data, err := xml.Marshal(xmlPayload{Value: MustNewBigInt(input)})
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			want := "<payload><value>" + input + "</value></payload>"
+			if string(data) != want {
+				t.Errorf("got %v, want %v", string(data), want)
+			}
+
+			var got xmlPayload
+			if err := xml.Unmarshal(data, &got); err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.Value.String() != input {
+				t.Errorf("got %v, want %v", got.Value.String(), input)
+			}
+		})
+	}
+}
+
+func TestBigIntUnmarshalXMLErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   error
+	}{
+		{
+			input: "<payload><value></value></payload>",
+			err:   ErrEmptyNumber,
+		},
+		{
+			input: "<payload><value/></payload>",
+			err:   ErrEmptyNumber,
+		},
+		{
+			input: "<payload><value>abc</value></payload>",
+			err:   ErrConvertingChunkToInteger,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			var got xmlPayload
+
+			err := xml.Unmarshal([]byte(tc.input), &got)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
@@ -14,6 +14,44 @@ var (
 	ErrInputWithDifferentNumbersCount = errors.New("input with different numbers count")
 	// ErrTrimmingDecimalPart is returned when the decimal part cannot be trimmed.
 	ErrTrimmingDecimalPart = errors.New("error trimming decimal part")
+	// ErrInvalidIntegerNumber is returned when the input contains a decimal point or an exponent.
+	ErrInvalidIntegerNumber = errors.New("invalid integer number")
+	// ErrOverflow is returned when a number does not fit in the requested type.
+	ErrOverflow = errors.New("number overflows the requested type")
+	// ErrInvalidBinaryEncoding is returned when the binary data cannot be decoded to a BigInt.
+	ErrInvalidBinaryEncoding = errors.New("invalid binary encoding")
+	// ErrUnsupportedBinaryVersion is returned when the binary data has a version this package can't decode.
+	ErrUnsupportedBinaryVersion = errors.New("unsupported binary encoding version")
+	// ErrEmptyNumber is returned when an empty value is decoded to a BigInt.
+	ErrEmptyNumber = errors.New("empty number")
+	// ErrUnsupportedVerb is returned when a BigInt is scanned with an unsupported verb.
+	ErrUnsupportedVerb = errors.New("unsupported verb")
+	// ErrUnsupportedScanType is returned when a database value cannot be scanned into a BigInt.
+	ErrUnsupportedScanType = errors.New("unsupported scan type")
+	// ErrResultTooLarge is returned when the result of an operation exceeds the allowed size.
+	ErrResultTooLarge = errors.New("result too large")
+	// ErrFrozen is returned when trying to modify one of the shared frozen values.
+	ErrFrozen = errors.New("cannot modify a frozen value")
+	// ErrOutOfRange is returned when an argument is out of the supported range.
+	ErrOutOfRange = errors.New("argument out of range")
+	// ErrFactorizingZero is returned when trying to factorize zero.
+	ErrFactorizingZero = errors.New("zero cannot be factorized")
+	// ErrZeroRoot is returned when the zeroth root of a number is requested.
+	ErrZeroRoot = errors.New("zeroth root is undefined")
+	// ErrNegativeRoot is returned when an even root of a negative number is requested.
+	ErrNegativeRoot = errors.New("even root of a negative number")
+	// ErrLogOfZero is returned when the logarithm of zero is requested.
+	ErrLogOfZero = errors.New("logarithm of zero is undefined")
+	// ErrInexact is returned when a number cannot be represented exactly in the requested type.
+	ErrInexact = errors.New("number cannot be represented exactly")
+	// ErrDivisionByZero is returned when dividing by zero.
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrEmptySlice is returned when an operation needs at least one number.
+	ErrEmptySlice = errors.New("empty slice")
+	// ErrNoInverse is returned when a number has no inverse modulo another one.
+	ErrNoInverse = errors.New("no modular inverse")
+	// ErrInvalidSeparator is returned when a digit group separator can't be told apart from the digits.
+	ErrInvalidSeparator = errors.New("invalid group separator")
 )
 
 // AddNumbers takse two string params containing M numbers
@@ -0,0 +1,60 @@
+package bignumber
+
+// Builder assembles a BigInt one decimal digit at a time, every pushed digit
+// becomes the new least significant digit, so the digits are pushed in the
+// order they are read, Ex: 1, 2, 3 builds 123. The zero value is an empty
+// builder ready to use.
+type Builder struct {
+	// chunks holds the complete chunks of maxChunkSize digits aligned to
+	// the first pushed digit, the most significant chunk first
+	chunks []uint64
+	// current holds the digits pushed since the last complete chunk
+	current uint64
+	// currentDigits is the number of digits in current
+	currentDigits int
+}
+
+// PushDigit appends d as the least significant digit of the value,
+// it returns ErrOutOfRange when d is not between 0 and 9.
+func (bd *Builder) PushDigit(d int) error {
+	if d < 0 || d > 9 {
+		return ErrOutOfRange
+	}
+
+	bd.current = bd.current*10 + uint64(d)
+	bd.currentDigits++
+
+	if bd.currentDigits == maxChunkSize {
+		bd.chunks = append(bd.chunks, bd.current)
+		bd.current, bd.currentDigits = 0, 0
+	}
+
+	return nil
+}
+
+// Build returns the BigInt of the pushed digits, leading zeros are dropped
+// and an empty builder builds zero. The builder keeps its digits, so more
+// digits can be pushed after building.
+func (bd *Builder) Build() *BigInt {
+	if bd.currentDigits == 0 {
+		return newBigIntFromMagnitude(append([]uint64{}, bd.chunks...), maxChunkSize)
+	}
+
+	// INFO: the chunks are aligned to the first digit, while the magnitude
+	// is aligned to the last one, so every chunk is shifted by the
+	// currentDigits digits of the incomplete chunk
+	shift := powersOfTen[bd.currentDigits]
+	split := powersOfTen[maxChunkSize-bd.currentDigits]
+
+	magnitude := make([]uint64, len(bd.chunks)+1)
+
+	var carry uint64
+	for idx, chunk := range bd.chunks {
+		magnitude[idx] = carry*shift + chunk/split
+		carry = chunk % split
+	}
+
+	magnitude[len(bd.chunks)] = carry*shift + bd.current
+
+	return newBigIntFromMagnitude(magnitude, maxChunkSize)
+}
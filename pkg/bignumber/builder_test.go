@@ -0,0 +1,87 @@
+package bignumber
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	tests := []string{
+		"",
+		"0",
+		"000",
+		"7",
+		"123456789012",
+		"123456789012345678",
+		"1234567890123456789",
+		"000000000000000000123",
+		"999999999999999999999999999999999999",
+		"123456789012345678901234567890123456789012345678901234567890",
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			var builder Builder
+
+			for _, digit := range tc {
+				if err := builder.PushDigit(int(digit - '0')); err != nil {
+					t.Fatalf("got %v, want nil", err)
+				}
+			}
+
+			want := NewZero()
+			if tc != "" {
+				want = MustNewBigInt(tc)
+			}
+
+			got := builder.Build()
+			if got.Cmp(want) != 0 || got.String() != want.String() {
+				t.Errorf("got %v, want %v", got.String(), want.String())
+			}
+
+			if got.Length() != want.Length() {
+				t.Errorf("got %v, want %v", got.Length(), want.Length())
+			}
+		})
+	}
+}
+
+func TestBuilderInvalidDigit(t *testing.T) {
+	var builder Builder
+
+	for _, digit := range []int{-1, 10, 'a'} {
+		if err := builder.PushDigit(digit); err != ErrOutOfRange {
+			t.Errorf("got %v, want %v", err, ErrOutOfRange)
+		}
+	}
+
+	if got := builder.Build(); got.String() != "0" {
+		t.Errorf("got %v, want %v", got.String(), "0")
+	}
+}
+
+func TestBuilderKeepsDigits(t *testing.T) {
+	var (
+		builder Builder
+		digits  strings.Builder
+	)
+
+	random := rand.New(rand.NewSource(1))
+
+	// Every snapshot must match the digits pushed so far
+	for range 100 {
+		digit := random.Intn(10)
+
+		_ = builder.PushDigit(digit)
+		digits.WriteByte(byte('0' + digit))
+
+		want := MustNewBigInt(digits.String())
+		if got := builder.Build(); got.String() != want.String() {
+			t.Fatalf("got %v, want %v", got.String(), want.String())
+		}
+	}
+}
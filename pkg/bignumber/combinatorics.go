@@ -0,0 +1,189 @@
+package bignumber
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Binomial returns the binomial coefficient "n choose k".
+//
+// It is computed incrementally as the product of (n-k+i)/i for i in 1..k,
+// so every intermediate value is itself a binomial coefficient and the
+// divisions are always exact. The consecutive factors are packed in uint64
+// groups reduced by their GCD, so the BigInt is multiplied and divided once
+// per group by the smallest possible values.
+func Binomial(n, k uint64) *BigInt {
+	if k > n {
+		return NewZero()
+	}
+
+	// INFO: C(n, k) == C(n, n-k), so use the smallest one to reduce the steps
+	if n-k < k {
+		k = n - k
+	}
+
+	base := powersOfTen[maxChunkSize]
+	result := []uint64{1}
+
+	for i := uint64(1); i <= k; {
+		// INFO: numerator/denominator is kept in lowest terms, C(n-k+j, j) is
+		// C(n-k+i-1, i-1) * (n-k+i)...(n-k+j) / i...j so the division of every
+		// packed group is exact too
+		numerator, denominator := uint64(1), uint64(1)
+
+		for ; i <= k; i++ {
+			factor, divisor := n-k+i, i
+
+			g := gcdUint64(factor, divisor)
+			factor, divisor = factor/g, divisor/g
+
+			g = gcdUint64(factor, denominator)
+			factor, nextDenominator := factor/g, denominator/g
+
+			g = gcdUint64(numerator, divisor)
+			nextNumerator, divisor := numerator/g, divisor/g
+
+			numeratorHi, numeratorLo := bits.Mul64(nextNumerator, factor)
+			denominatorHi, denominatorLo := bits.Mul64(nextDenominator, divisor)
+
+			if numeratorHi != 0 || denominatorHi != 0 {
+				break
+			}
+
+			numerator, denominator = numeratorLo, denominatorLo
+		}
+
+		result = mulMagnitudeUint64(result, base, numerator)
+		divModUint64(result, result, base, denominator)
+		result = trimLeadingZeroChunks(result)
+	}
+
+	return newBigIntFromMagnitude(result, maxChunkSize)
+}
+
+// gcdUint64 returns the greatest common divisor of a and b.
+func gcdUint64(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+// Combinations returns the number of ways to choose k elements out of n
+// regardless of their order, it is the same as Binomial.
+func Combinations(n, k uint) *BigInt {
+	return Binomial(uint64(n), uint64(k))
+}
+
+// Permutations returns the number of ordered arrangements of k elements
+// out of n, n!/(n-k)!, or zero when k > n.
+//
+// It is computed as the product of n, n-1, ..., n-k+1, so the factorials
+// are never built.
+func Permutations(n, k uint) *BigInt {
+	if k > n {
+		return NewZero()
+	}
+
+	base := powersOfTen[maxChunkSize]
+	result := []uint64{1}
+
+	for i := range k {
+		result = mulMagnitudeUint64(result, base, uint64(n-i))
+	}
+
+	return newBigIntFromMagnitude(result, maxChunkSize)
+}
+
+// Catalan returns the nth Catalan number computed as C(2n, n) / (n+1).
+// It panics when 2n doesn't fit in an uint, such a number would have more
+// digits than fit in memory anyway.
+func Catalan(n uint) *BigInt {
+	if n > math.MaxUint/2 {
+		panic("bignumber: Catalan index out of range")
+	}
+
+	binomial := Binomial(uint64(2*n), uint64(n))
+
+	// INFO: C(2n, n) is always a multiple of n+1, so the division is exact
+	magnitude := binomial.magnitude
+	divModUint64(magnitude, magnitude, binomial.base(), uint64(n)+1)
+
+	return newBigIntFromMagnitude(magnitude, binomial.chunkSize())
+}
+
+// Fibonacci returns the nth Fibonacci number, F(0) = 0, F(1) = 1 and
+// F(n) = F(n-1) + F(n-2), using the fast doubling identities
+//
+//	F(2k+1) = 4F(k)^2 - F(k-1)^2 + 2(-1)^k
+//	F(2k-1) = F(k)^2 + F(k-1)^2
+//	F(2k)   = F(2k+1) - F(2k-1)
+//
+// driven by the bits of n, so it takes two squarings per bit.
+func Fibonacci(n uint64) *BigInt {
+	if n == 0 {
+		return NewZero()
+	}
+
+	base := powersOfTen[maxChunkSize]
+	two := []uint64{2}
+
+	// INFO: the pair holds F(k) and F(k-1) for the bits of n read so far,
+	// starting from the leading bit with k = 1
+	current, previous := []uint64{1}, []uint64{0}
+	odd := true
+
+	for bit := bits.Len64(n) - 2; bit >= 0; bit-- {
+		currentSquared := trimLeadingZeroChunks(mulMagnitudes(current, current, base))
+		previousSquared := trimLeadingZeroChunks(mulMagnitudes(previous, previous, base))
+
+		nextOdd := subMagnitudes(mulMagnitudeUint64(currentSquared, base, 4), previousSquared, base)
+		if odd {
+			nextOdd = subMagnitudes(nextOdd, two, base)
+		} else {
+			nextOdd = addMagnitudes(nextOdd, two, base)
+		}
+
+		nextOdd = trimLeadingZeroChunks(nextOdd)
+		previousOdd := trimLeadingZeroChunks(addMagnitudes(currentSquared, previousSquared, base))
+		even := trimLeadingZeroChunks(subMagnitudes(nextOdd, previousOdd, base))
+
+		if odd = n>>bit&1 == 1; odd {
+			current, previous = nextOdd, even
+		} else {
+			current, previous = even, previousOdd
+		}
+	}
+
+	return newBigIntFromMagnitude(current, maxChunkSize)
+}
+
+// Triangular returns the nth triangular number n(n+1)/2,
+// Ex: 4 returns 10 which is 1 + 2 + 3 + 4.
+func Triangular(n *BigInt) *BigInt {
+	// INFO: either n or n+1 is even, so halving the product is exact
+	return n.Mul(n.Inc()).Half()
+}
+
+// PascalRow returns the nth row of Pascal's triangle, the binomial
+// coefficients C(n, 0) to C(n, n).
+//
+// Each row is built from the previous one, every inner entry being the
+// sum of the two entries above it.
+func PascalRow(n uint) []*BigInt {
+	row := []*BigInt{NewOne()}
+
+	for range n {
+		next := make([]*BigInt, len(row)+1)
+		next[0], next[len(row)] = NewOne(), NewOne()
+
+		for idx := 1; idx < len(row); idx++ {
+			next[idx] = row[idx-1].Add(row[idx])
+		}
+
+		row = next
+	}
+
+	return row
+}
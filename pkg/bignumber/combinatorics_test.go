@@ -0,0 +1,408 @@
+package bignumber
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestBinomial(t *testing.T) {
+	tests := []struct {
+		n    uint64
+		k    uint64
+		want string
+	}{
+		{
+			n:    0,
+			k:    0,
+			want: "1",
+		},
+		{
+			n:    10,
+			k:    0,
+			want: "1",
+		},
+		{
+			n:    10,
+			k:    10,
+			want: "1",
+		},
+		{
+			n:    10,
+			k:    11,
+			want: "0",
+		},
+		{
+			n:    52,
+			k:    5,
+			want: "2598960",
+		},
+		{
+			n:    1000,
+			k:    500,
+			want: new(big.Int).Binomial(1000, 500).String(),
+		},
+		{
+			n:    2000,
+			k:    3,
+			want: new(big.Int).Binomial(2000, 3).String(),
+		},
+		{
+			n:    1 << 40,
+			k:    7,
+			want: new(big.Int).Binomial(1<<40, 7).String(),
+		},
+		{
+			n:    math.MaxUint64,
+			k:    math.MaxUint64 - 1,
+			want: "18446744073709551615",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := Binomial(tc.n, tc.k); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestBinomialAgainstBig(t *testing.T) {
+	random := rand.New(rand.NewSource(265))
+
+	for idx := range 100 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		// INFO: the large n keep a single factor per packed group
+		n := uint64(random.Intn(3000))
+		if idx%4 == 0 {
+			n = uint64(random.Int31())
+		}
+
+		k := uint64(random.Intn(int(min(n, 400)) + 1))
+
+		t.Run(testname, func(t *testing.T) {
+			want := new(big.Int).Binomial(int64(n), int64(k))
+
+			if got := Binomial(n, k); got.String() != want.String() {
+				t.Errorf("C(%v, %v): got %v, want %v", n, k, got, want)
+			}
+		})
+	}
+}
+
+func TestFibonacci(t *testing.T) {
+	tests := []struct {
+		n    uint64
+		want string
+	}{
+		{
+			n:    0,
+			want: "0",
+		},
+		{
+			n:    1,
+			want: "1",
+		},
+		{
+			n:    2,
+			want: "1",
+		},
+		{
+			n:    10,
+			want: "55",
+		},
+		{
+			n:    93,
+			want: "12200160415121876738",
+		},
+		{
+			n:    100,
+			want: "354224848179261915075",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := Fibonacci(tc.n); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestFibonacciRecurrence(t *testing.T) {
+	previous, current := big.NewInt(0), big.NewInt(1)
+
+	for n := uint64(1); n <= 3000; n++ {
+		if got := Fibonacci(n); got.String() != current.String() {
+			t.Fatalf("F(%v): got %v, want %v", n, got, current)
+		}
+
+		previous, current = current, previous.Add(previous, current)
+	}
+}
+
+func TestCatalan(t *testing.T) {
+	want := []string{
+		"1", "1", "2", "5", "14", "42", "132", "429", "1430", "4862", "16796", "58786",
+	}
+
+	for idx, tc := range want {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := Catalan(uint(idx)); got.String() != tc {
+				t.Errorf("got %v, want %v", got.String(), tc)
+			}
+		})
+	}
+}
+
+func TestCatalanLarge(t *testing.T) {
+	n := int64(500)
+
+	want := new(big.Int).Binomial(2*n, n)
+	want.Quo(want, big.NewInt(n+1))
+
+	if got := Catalan(uint(n)); got.String() != want.String() {
+		t.Errorf("got %v, want %v", got.String(), want.String())
+	}
+}
+
+func TestCatalanOutOfRange(t *testing.T) {
+	for idx, n := range []uint{math.MaxUint/2 + 1, math.MaxUint} {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("got no panic, want panic")
+				}
+			}()
+
+			Catalan(n)
+		})
+	}
+}
+
+func TestTriangular(t *testing.T) {
+	tests := []struct {
+		n    string
+		want string
+	}{
+		{
+			n:    "0",
+			want: "0",
+		},
+		{
+			n:    "1",
+			want: "1",
+		},
+		{
+			n:    "4",
+			want: "10",
+		},
+		{
+			n:    "10",
+			want: "55",
+		},
+		{
+			n:    "999999999",
+			want: "499999999500000000",
+		},
+		{
+			n:    "123456789012345678901234567890",
+			want: "7620789376619418375247675781329827788257125439388126809995",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := Triangular(MustNewBigInt(tc.n)); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestPascalRow(t *testing.T) {
+	tests := []struct {
+		n    uint
+		want []string
+	}{
+		{
+			n:    0,
+			want: []string{"1"},
+		},
+		{
+			n:    1,
+			want: []string{"1", "1"},
+		},
+		{
+			n:    4,
+			want: []string{"1", "4", "6", "4", "1"},
+		},
+		{
+			n:    6,
+			want: []string{"1", "6", "15", "20", "15", "6", "1"},
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			row := PascalRow(tc.n)
+
+			got := make([]string, len(row))
+			for idx, value := range row {
+				got[idx] = value.String()
+			}
+
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPascalRowSum(t *testing.T) {
+	two := MustNewBigInt("2")
+
+	for n := uint(0); n <= 50; n++ {
+		testname := fmt.Sprintf("test#%d", n)
+
+		t.Run(testname, func(t *testing.T) {
+			row := PascalRow(n)
+
+			if len(row) != int(n)+1 {
+				t.Fatalf("got %v entries, want %v", len(row), n+1)
+			}
+
+			// INFO: the row is also checked entry by entry against Binomial
+			for k, value := range row {
+				if want := Binomial(uint64(n), uint64(k)); value.String() != want.String() {
+					t.Errorf("C(%v, %v): got %v, want %v", n, k, value, want)
+				}
+			}
+
+			if got, want := Sum(row), two.Pow(uint64(n)); got.String() != want.String() {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	tests := []struct {
+		n    uint
+		k    uint
+		want string
+	}{
+		{
+			n:    0,
+			k:    0,
+			want: "1",
+		},
+		{
+			n:    5,
+			k:    0,
+			want: "1",
+		},
+		{
+			n:    5,
+			k:    2,
+			want: "20",
+		},
+		{
+			n:    10,
+			k:    10,
+			want: "3628800",
+		},
+		{
+			n:    52,
+			k:    5,
+			want: "311875200",
+		},
+		{
+			n:    3,
+			k:    4,
+			want: "0",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := Permutations(tc.n, tc.k); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestPermutationsAndCombinationsFromFactorials(t *testing.T) {
+	var cache FactorialCache
+
+	for n := uint(0); n <= 40; n++ {
+		for k := uint(0); k <= n; k++ {
+			permutations, err := cache.Factorial(n).DivExact(cache.Factorial(n - k))
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got := Permutations(n, k); got.String() != permutations.String() {
+				t.Errorf("P(%v, %v): got %v, want %v", n, k, got, permutations)
+			}
+
+			combinations, err := permutations.DivExact(cache.Factorial(k))
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got := Combinations(n, k); got.String() != combinations.String() {
+				t.Errorf("C(%v, %v): got %v, want %v", n, k, got, combinations)
+			}
+		}
+	}
+
+	if got := Combinations(3, 4); got.String() != "0" {
+		t.Errorf("got %v, want %v", got, "0")
+	}
+}
+
+func BenchmarkBinomial(b *testing.B) {
+	for _, tc := range []struct{ n, k uint64 }{{1000, 500}, {20000, 10000}, {1 << 40, 300}} {
+		b.Run(fmt.Sprintf("n=%d/k=%d", tc.n, tc.k), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				Binomial(tc.n, tc.k)
+			}
+		})
+	}
+}
+
+func BenchmarkFibonacci(b *testing.B) {
+	for _, n := range []uint64{1000, 100000, 1000000} {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				Fibonacci(n)
+			}
+		})
+	}
+}
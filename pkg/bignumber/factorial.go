@@ -0,0 +1,150 @@
+package bignumber
+
+import (
+	"math/bits"
+	"slices"
+	"sync"
+)
+
+// factorialLeafSize is the number of factors below which the product tree
+// of Factorial multiplies the factors one by one.
+const factorialLeafSize = 64
+
+// Factorial returns n! using a balanced product tree, the factors are split
+// in two halves that are multiplied recursively, so the big multiplications
+// are between operands of similar size and go through Karatsuba, Toom-3 or
+// the NTT. Unlike FactorialCache nothing is kept between the calls.
+func Factorial(n uint64) *BigInt {
+	if n < 2 {
+		return NewOne()
+	}
+
+	return newBigIntFromMagnitude(productRange(2, n, powersOfTen[maxChunkSize]), maxChunkSize)
+}
+
+// productRange returns the magnitude of the product of the integers from
+// low to high, both included.
+func productRange(low, high, base uint64) []uint64 {
+	if high-low < factorialLeafSize {
+		result := []uint64{1}
+		packed := uint64(1)
+
+		// INFO: the factors are packed in an uint64 while the product fits,
+		// so the magnitude is multiplied once per packed group
+		for factor := low; ; factor++ {
+			if hi, lo := bits.Mul64(packed, factor); hi == 0 {
+				packed = lo
+			} else {
+				result = mulMagnitudeUint64(result, base, packed)
+				packed = factor
+			}
+
+			if factor == high {
+				break
+			}
+		}
+
+		return trimLeadingZeroChunks(mulMagnitudeUint64(result, base, packed))
+	}
+
+	middle := low + (high-low)/2
+
+	return trimLeadingZeroChunks(mulMagnitudes(productRange(low, middle, base), productRange(middle+1, high, base), base))
+}
+
+// FactorialCache computes factorials and remembers a few of them, so a new
+// factorial is built from the closest cached one below it instead of starting
+// from one. The zero value is an empty cache ready to use, and it is safe for
+// concurrent use.
+//
+// INFO: keeping every n! would take O(n^2) digits, the factorials of the
+// powers of two only add up to about twice the size of the largest one.
+type FactorialCache struct {
+	mu sync.RWMutex
+
+	// checkpoints holds the magnitude of (2^i)! at index i, nil when it
+	// hasn't been computed yet
+	checkpoints [][]uint64
+	// largest is the largest n computed so far and largestValue its n!
+	largest      uint
+	largestValue []uint64
+}
+
+// Factorial returns n!, the returned BigInt is a copy so it can be freely
+// modified without affecting the cache. The factors above the closest cached
+// factorial are multiplied with the product tree of Factorial.
+func (c *FactorialCache) Factorial(n uint) *BigInt {
+	c.mu.RLock()
+	start, magnitude := c.closest(n)
+	c.mu.RUnlock()
+
+	if start == n {
+		return newBigIntFromMagnitude(slices.Clone(magnitude), maxChunkSize)
+	}
+
+	base := powersOfTen[maxChunkSize]
+
+	// INFO: the products are computed without holding the lock, two goroutines
+	// may compute the same values but they never wait on each other
+	var (
+		checkpoints []uint
+		values      [][]uint64
+	)
+
+	for power := uint(1) << bits.Len(start); power != 0 && power <= n; power <<= 1 {
+		magnitude = trimLeadingZeroChunks(mulMagnitudes(magnitude, productRange(uint64(start)+1, uint64(power), base), base))
+		start = power
+
+		checkpoints = append(checkpoints, power)
+		values = append(values, magnitude)
+	}
+
+	if start < n {
+		magnitude = trimLeadingZeroChunks(mulMagnitudes(magnitude, productRange(uint64(start)+1, uint64(n), base), base))
+	}
+
+	c.mu.Lock()
+
+	for i, power := range checkpoints {
+		idx := bits.Len(power) - 1
+
+		if idx >= len(c.checkpoints) {
+			c.checkpoints = append(c.checkpoints, make([][]uint64, idx+1-len(c.checkpoints))...)
+		}
+
+		if c.checkpoints[idx] == nil {
+			c.checkpoints[idx] = values[i]
+		}
+	}
+
+	if n > c.largest {
+		c.largest, c.largestValue = n, magnitude
+	}
+
+	c.mu.Unlock()
+
+	return newBigIntFromMagnitude(slices.Clone(magnitude), maxChunkSize)
+}
+
+// closest returns the largest cached m <= n and the magnitude of m!,
+// the caller must hold the lock.
+func (c *FactorialCache) closest(n uint) (uint, []uint64) {
+	// INFO: 0! and 1! are 1, they are the starting point of an empty cache
+	start, magnitude := min(n, 1), []uint64{1}
+
+	if c.largestValue != nil && c.largest <= n {
+		start, magnitude = c.largest, c.largestValue
+	}
+
+	for idx := min(len(c.checkpoints), bits.Len(n)) - 1; idx >= 0; idx-- {
+		if power := uint(1) << idx; power <= start {
+			break
+		}
+
+		if c.checkpoints[idx] != nil {
+			return uint(1) << idx, c.checkpoints[idx]
+		}
+	}
+
+	return start, magnitude
+}
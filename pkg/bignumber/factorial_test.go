@@ -0,0 +1,187 @@
+package bignumber
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestFactorial(t *testing.T) {
+	for idx, n := range []uint64{0, 1, 2, 5, 20, 21, 63, 64, 65, 100, 1000, 4321} {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			want := new(big.Int).MulRange(1, int64(n)).String()
+
+			if got := Factorial(n).String(); got != want {
+				t.Errorf("%v!: got %v digits, want %v digits", n, len(got), len(want))
+			}
+		})
+	}
+}
+
+func TestFactorialMatchesCache(t *testing.T) {
+	var cache FactorialCache
+
+	for n := range uint64(200) {
+		if got, want := Factorial(n), cache.Factorial(uint(n)); got.Cmp(want) != 0 {
+			t.Errorf("%v!: got %v, want %v", n, got, want)
+		}
+	}
+}
+
+func TestFactorialCache(t *testing.T) {
+	tests := []struct {
+		input uint
+		want  string
+	}{
+		{
+			input: 0,
+			want:  "1",
+		},
+		{
+			input: 1,
+			want:  "1",
+		},
+		{
+			input: 5,
+			want:  "120",
+		},
+		{
+			input: 20,
+			want:  "2432902008176640000",
+		},
+		{
+			input: 3,
+			want:  "6",
+		},
+		{
+			input: 30,
+			want:  "265252859812191058636308480000000",
+		},
+	}
+
+	// INFO: the cache is shared between the cases, so they
+	// cover both the cached and the extended values
+	var cache FactorialCache
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := cache.Factorial(tc.input).String(); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFactorialCacheReturnsACopy(t *testing.T) {
+	var cache FactorialCache
+
+	value := cache.Factorial(10)
+	if err := value.AddInPlace(MustNewBigInt("1")); err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+
+	if got := cache.Factorial(10).String(); got != "3628800" {
+		t.Errorf("got %v, want %v", got, "3628800")
+	}
+}
+
+func TestFactorialCacheRandomOrder(t *testing.T) {
+	var cache FactorialCache
+
+	random := rand.New(rand.NewSource(158))
+
+	for idx := range 100 {
+		n := uint(random.Intn(3000))
+		if idx%10 == 0 {
+			n = 1 << random.Intn(12)
+		}
+
+		want := new(big.Int).MulRange(1, int64(n)).String()
+
+		if got := cache.Factorial(n).String(); got != want {
+			t.Fatalf("%v!: got %v digits, want %v digits", n, len(got), len(want))
+		}
+	}
+}
+
+func TestFactorialCacheKeepsCheckpoints(t *testing.T) {
+	var cache FactorialCache
+
+	for n := uint(0); n <= 1000; n++ {
+		cache.Factorial(n)
+	}
+
+	// INFO: only the powers of two up to 512 and the largest value are kept
+	if len(cache.checkpoints) != 10 {
+		t.Errorf("got %v checkpoints, want %v", len(cache.checkpoints), 10)
+	}
+
+	if cache.largest != 1000 {
+		t.Errorf("got %v, want %v", cache.largest, 1000)
+	}
+}
+
+func TestFactorialCacheConcurrent(t *testing.T) {
+	var (
+		cache FactorialCache
+		group sync.WaitGroup
+	)
+
+	for n := uint(0); n < 200; n++ {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			want := new(big.Int).MulRange(1, int64(n)).String()
+
+			if got := cache.Factorial(n).String(); got != want {
+				t.Errorf("%v!: got %v, want %v", n, got, want)
+			}
+		}()
+	}
+
+	group.Wait()
+}
+
+func BenchmarkFactorialCache(b *testing.B) {
+	var cache FactorialCache
+
+	// INFO: warm up the cache so the benchmark measures the lookups
+	cache.Factorial(1000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		cache.Factorial(1000)
+	}
+}
+
+func BenchmarkFactorialUncached(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var cache FactorialCache
+
+		cache.Factorial(1000)
+	}
+}
+
+func BenchmarkFactorial(b *testing.B) {
+	for _, n := range []uint64{1000, 10000, 100000} {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				Factorial(n)
+			}
+		})
+	}
+}
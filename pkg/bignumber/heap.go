@@ -0,0 +1,52 @@
+package bignumber
+
+// BigIntHeap is a min-heap of BigInts that implements heap.Interface,
+// the values are ordered by Cmp. Use it through the container/heap functions:
+//
+//	h := &BigIntHeap{}
+//	heap.Push(h, MustNewBigInt("7"))
+//	lowest := heap.Pop(h).(*BigInt)
+type BigIntHeap []*BigInt
+
+// Len returns the number of values in the heap.
+func (h BigIntHeap) Len() int {
+	return len(h)
+}
+
+// Less reports whether the value at i is lower than the value at j.
+func (h BigIntHeap) Less(i, j int) bool {
+	return h[i].Cmp(h[j]) < 0
+}
+
+// Swap swaps the values at i and j.
+func (h BigIntHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+// Push appends x to the heap, x must be a *BigInt.
+func (h *BigIntHeap) Push(x any) {
+	*h = append(*h, x.(*BigInt))
+}
+
+// Pop removes and returns the last value of the heap.
+func (h *BigIntHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+
+	// INFO: clear the reference so the popped value can be garbage collected
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+
+	return last
+}
+
+// BigIntMaxHeap is a max-heap of BigInts that implements heap.Interface,
+// it is a BigIntHeap with the order reversed.
+type BigIntMaxHeap struct {
+	BigIntHeap
+}
+
+// Less reports whether the value at i is greater than the value at j.
+func (h BigIntMaxHeap) Less(i, j int) bool {
+	return h.BigIntHeap.Less(j, i)
+}
@@ -0,0 +1,112 @@
+package bignumber
+
+import (
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+var (
+	_ heap.Interface = &BigIntHeap{}
+	_ heap.Interface = &BigIntMaxHeap{}
+)
+
+// randomBigInts returns count random values of up to 60 digits, with repeats.
+func randomBigInts(random *rand.Rand, count int) []*BigInt {
+	values := make([]*BigInt, count)
+
+	for idx := range values {
+		digits := make([]byte, 1+random.Intn(60))
+		for digitIdx := range digits {
+			digits[digitIdx] = byte('0' + random.Intn(10))
+		}
+
+		values[idx] = MustNewBigInt(string(digits))
+	}
+
+	// INFO: repeat some values to check the equal ones
+	for idx := 0; idx < count/10; idx++ {
+		values[random.Intn(count)] = values[random.Intn(count)]
+	}
+
+	return values
+}
+
+func TestBigIntHeap(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for idx := range 10 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			values := randomBigInts(random, 1+random.Intn(200))
+
+			h := &BigIntHeap{}
+			for _, value := range values {
+				heap.Push(h, value)
+			}
+
+			sorted := slices.Clone(values)
+			BigInts(sorted).Sort()
+
+			for _, want := range sorted {
+				if got := heap.Pop(h).(*BigInt); got.Cmp(want) != 0 {
+					t.Fatalf("got %v, want %v", got, want)
+				}
+			}
+
+			if h.Len() != 0 {
+				t.Errorf("got %v, want %v", h.Len(), 0)
+			}
+		})
+	}
+}
+
+func TestBigIntMaxHeap(t *testing.T) {
+	random := rand.New(rand.NewSource(2))
+
+	for idx := range 10 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			values := randomBigInts(random, 1+random.Intn(200))
+
+			// INFO: heap.Init must build the invariant over the existing values
+			h := &BigIntMaxHeap{BigIntHeap: slices.Clone(values)}
+			heap.Init(h)
+
+			sorted := slices.Clone(values)
+			BigInts(sorted).SortDescending()
+
+			for _, want := range sorted {
+				if got := heap.Pop(h).(*BigInt); got.Cmp(want) != 0 {
+					t.Fatalf("got %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBigIntHeapInterleaved(t *testing.T) {
+	h := &BigIntHeap{}
+
+	for _, value := range []string{"50", "10", "123456789012345678901234567890", "30"} {
+		heap.Push(h, MustNewBigInt(value))
+	}
+
+	if got := heap.Pop(h).(*BigInt); got.String() != "10" {
+		t.Errorf("got %v, want %v", got, "10")
+	}
+
+	heap.Push(h, MustNewBigInt("5"))
+	heap.Push(h, MustNewBigInt("40"))
+
+	want := []string{"5", "30", "40", "50", "123456789012345678901234567890"}
+	for _, value := range want {
+		if got := heap.Pop(h).(*BigInt); got.String() != value {
+			t.Errorf("got %v, want %v", got, value)
+		}
+	}
+}
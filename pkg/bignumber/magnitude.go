@@ -0,0 +1,641 @@
+package bignumber
+
+import (
+	"math"
+	"math/bits"
+)
+
+// isZeroMagnitude reports whether every chunk of the magnitude is zero.
+func isZeroMagnitude(magnitude []uint64) bool {
+	for _, chunk := range magnitude {
+		if chunk != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newBigIntFromMagnitude creates a new BigInt from a magnitude
+// trimming the leading zero chunks and computing its length.
+func newBigIntFromMagnitude(magnitude []uint64, chunkSize int) *BigInt {
+	bigInt := &BigInt{
+		magnitude: trimLeadingZeroChunks(magnitude),
+		chukSize:  chunkSize,
+	}
+	bigInt.length = bigInt.digits()
+
+	return bigInt
+}
+
+// addMagnitudes adds two magnitudes stored in chunks of the given base.
+func addMagnitudes(lhs, rhs []uint64, base uint64) []uint64 {
+	// Make sure the larger magnitude is always on the left
+	if len(lhs) < len(rhs) {
+		lhs, rhs = rhs, lhs
+	}
+
+	// INFO: the extra leading chunk holds the last carry,
+	// so the result never has to be reallocated
+	result := make([]uint64, len(lhs)+1)
+
+	var carry uint64
+
+	for offset := 1; offset <= len(lhs); offset++ {
+		lhsIndex := len(lhs) - offset
+		rhsIndex := len(rhs) - offset
+
+		// rhs may be shorter than lhs, its missing chunks are zero
+		var rhsChunk uint64
+		if rhsIndex >= 0 {
+			rhsChunk = rhs[rhsIndex]
+		}
+
+		carry, result[lhsIndex+1] = addChunks(lhs[lhsIndex], rhsChunk, carry, base)
+	}
+
+	if carry == 0 {
+		return result[1:]
+	}
+
+	result[0] = carry
+
+	return result
+}
+
+// addMagnitudeInPlace adds src to dst in place and returns the carry that
+// overflows dst, which must have at least as many chunks as src.
+func addMagnitudeInPlace(dst, src []uint64, base uint64) uint64 {
+	var carry uint64
+
+	for offset := 1; offset <= len(dst); offset++ {
+		idx := len(dst) - offset
+
+		// Once src is consumed only the carry is left to propagate
+		if offset > len(src) {
+			if carry == 0 {
+				break
+			}
+
+			carry, dst[idx] = addChunks(dst[idx], 0, carry, base)
+
+			continue
+		}
+
+		carry, dst[idx] = addChunks(dst[idx], src[len(src)-offset], carry, base)
+	}
+
+	return carry
+}
+
+// addChunks returns the carry and the chunk of lhs + rhs + carry in the given base.
+//
+// INFO: the sum is computed on 128 bits, so the chunks that don't fit in
+// the base, up to math.MaxUint64, can't overflow it. The high word is at
+// most 2, lower than the base, so the division can't overflow either.
+func addChunks(lhs, rhs, carry, base uint64) (uint64, uint64) {
+	sum, hi := bits.Add64(lhs, rhs, 0)
+	sum, overflow := bits.Add64(sum, carry, 0)
+
+	return bits.Div64(hi+overflow, sum, base)
+}
+
+// subMagnitudes subtracts rhs from lhs, both stored in chunks of the given base.
+// The lhs magnitude must be greater than or equal to rhs.
+func subMagnitudes(lhs, rhs []uint64, base uint64) []uint64 {
+	result := append(make([]uint64, 0, len(lhs)), lhs...)
+	subMagnitudeInPlace(result, rhs, base)
+
+	return trimLeadingZeroChunks(result)
+}
+
+// subMagnitudeInPlace subtracts src from dst in place, both stored in chunks
+// of the given base. The dst magnitude must be greater than or equal to src.
+func subMagnitudeInPlace(dst, src []uint64, base uint64) {
+	var borrow uint64
+
+	for offset := 1; offset <= len(dst); offset++ {
+		dstIndex := len(dst) - offset
+		srcIndex := len(src) - offset
+
+		// Once src is consumed only the borrow is left to propagate
+		if srcIndex < 0 && borrow == 0 {
+			break
+		}
+
+		// The src chunk defaults to 0 when src is shorter than dst
+		srcChunk := borrow
+		if srcIndex >= 0 {
+			srcChunk += src[srcIndex]
+		}
+
+		// Borrow from the next chunk when the subtraction would be negative
+		dstChunk := dst[dstIndex]
+		borrow = 0
+
+		if dstChunk < srcChunk {
+			dstChunk += base
+			borrow = 1
+		}
+
+		dst[dstIndex] = dstChunk - srcChunk
+	}
+}
+
+// mulMagnitudes multiplies two magnitudes using the schoolbook algorithm.
+func mulMagnitudes(lhs, rhs []uint64, base uint64) []uint64 {
+	result := make([]uint64, len(lhs)+len(rhs))
+	mulMagnitudesInto(result, lhs, rhs, base)
+
+	return trimLeadingZeroChunks(result)
+}
+
+// mulMagnitudesInto multiplies lhs by rhs and stores the product in dst,
+// which must be zeroed and have exactly len(lhs)+len(rhs) chunks.
+// The chunks of lhs and rhs must be lower than the base.
+func mulMagnitudesInto(dst, lhs, rhs []uint64, base uint64) {
+	// INFO: the faster algorithms only pay off when both operands are large,
+	// the chain goes schoolbook -> Karatsuba -> Toom-3 -> NTT as the operands grow
+	switch size := min(len(lhs), len(rhs)); {
+	case size >= nttThreshold:
+		mulMagnitudesNTT(dst, lhs, rhs, base)
+
+		return
+	case size >= toomThreshold:
+		mulMagnitudesToom3(dst, lhs, rhs, base)
+
+		return
+	case size >= max(KaratsubaThreshold, 2):
+		// INFO: single chunks can't be split, so they always go through the schoolbook
+		mulMagnitudesKaratsuba(dst, lhs, rhs, base)
+
+		return
+	}
+
+	for i := len(lhs) - 1; i >= 0; i-- {
+		if lhs[i] == 0 {
+			continue
+		}
+
+		var carry uint64
+
+		for j := len(rhs) - 1; j >= 0; j-- {
+			// INFO: (base-1)^2 + 2*(base-1) < base^2, so the high word of the
+			// sum is always lower than the base and the division can't overflow
+			hi, lo := bits.Mul64(lhs[i], rhs[j])
+			lo, overflow := bits.Add64(lo, dst[i+j+1], 0)
+			hi += overflow
+			lo, overflow = bits.Add64(lo, carry, 0)
+			hi += overflow
+
+			carry, dst[i+j+1] = bits.Div64(hi, lo, base)
+		}
+
+		// INFO: dst[i] has not been written yet by the previous rows
+		dst[i] = carry
+	}
+}
+
+// powMagnitude raises the magnitude to the given exponent using exponentiation by squaring.
+// The chunks of the magnitude must be lower than the base.
+func powMagnitude(magnitude []uint64, exponent uint64, base uint64) []uint64 {
+	magnitude = trimLeadingZeroChunks(magnitude)
+
+	if exponent == 0 {
+		return []uint64{1}
+	}
+
+	// INFO: the result and the square are the only live values, so three
+	// buffers large enough for the result are swapped between the products
+	// instead of allocating a new product on every step
+	var buffers [3][]uint64
+
+	size := powChunks(magnitude, exponent, base)
+	for idx := range buffers {
+		buffers[idx] = make([]uint64, size)
+	}
+
+	result, square := []uint64{1}, magnitude
+	resultBuffer, squareBuffer := -1, -1
+
+	// multiply stores lhs*rhs in the buffer that holds neither the result nor the square
+	multiply := func(lhs, rhs []uint64) ([]uint64, int) {
+		idx := 0
+		for idx == resultBuffer || idx == squareBuffer {
+			idx++
+		}
+
+		product := buffers[idx][:len(lhs)+len(rhs)]
+		clear(product)
+		mulMagnitudesInto(product, lhs, rhs, base)
+
+		return trimLeadingZeroChunks(product), idx
+	}
+
+	for exponent > 0 {
+		if exponent&1 == 1 {
+			result, resultBuffer = multiply(result, square)
+		}
+
+		exponent >>= 1
+
+		if exponent > 0 {
+			square, squareBuffer = multiply(square, square)
+		}
+	}
+
+	return result
+}
+
+// powChunks returns an upper bound of the chunks needed by any product
+// computed while raising the magnitude to the given exponent.
+func powChunks(magnitude []uint64, exponent uint64, base uint64) int {
+	chunkSize := chunkDigits(base) - 1
+
+	// INFO: the digits of the result are at most exponent*log10(magnitude) + 1,
+	// the log10 is rounded up with the leading chunk plus one
+	logarithm := math.Log10(float64(magnitude[0])+1) + float64((len(magnitude)-1)*chunkSize)
+	chunks := int(math.Ceil(float64(exponent)*logarithm/float64(chunkSize))) + 1
+
+	// The operands of a product have at most one more chunk than the product
+	return max(chunks, len(magnitude)) + 1
+}
+
+// toWords converts the magnitude to base 2^32 words,
+// from the least significant to the most significant word.
+// Zero is represented with no words at all.
+func toWords(magnitude []uint64, base uint64) []uint32 {
+	// Work on a copy since the division is done in place
+	normalized := normalizeMagnitude(magnitude, base)
+	work := make([]uint64, len(normalized))
+	copy(work, normalized)
+
+	var words []uint32
+
+	for !isZeroMagnitude(work) {
+		remainder := divModUint64(work, work, base, 1<<32)
+
+		words = append(words, uint32(remainder))
+		work = trimLeadingZeroChunks(work)
+	}
+
+	return words
+}
+
+// testWordBit returns the value of the i'th bit of the words.
+func testWordBit(words []uint32, i int) uint {
+	word := i / 32
+	if word >= len(words) {
+		return 0
+	}
+
+	return uint(words[word]>>(i%32)) & 1
+}
+
+// wordsBitLen returns the length of the words in bits.
+func wordsBitLen(words []uint32) int {
+	if len(words) == 0 {
+		return 0
+	}
+
+	return (len(words)-1)*32 + bits.Len32(words[len(words)-1])
+}
+
+// mulMagnitudeUint64 multiplies the magnitude by an uint64 factor,
+// the chunks of the magnitude must be lower than the base.
+func mulMagnitudeUint64(magnitude []uint64, base, factor uint64) []uint64 {
+	// INFO: the carry is lower than the factor, so it never needs
+	// more extra chunks than the ones of the largest uint64
+	extra := 0
+	for rest := uint64(math.MaxUint64); rest > 0; rest /= base {
+		extra++
+	}
+
+	result := make([]uint64, len(magnitude)+extra)
+	offset := len(result) - len(magnitude)
+
+	var carry uint64
+
+	for idx := len(magnitude) - 1; idx >= 0; idx-- {
+		// INFO: carry < factor, so the quotient always fits in an uint64
+		hi, lo := bits.Mul64(magnitude[idx], factor)
+		lo, overflow := bits.Add64(lo, carry, 0)
+
+		carry, result[offset+idx] = bits.Div64(hi+overflow, lo, base)
+	}
+
+	// Spread the remaining carry on the extra chunks
+	for idx := offset - 1; idx >= 0; idx-- {
+		result[idx] = carry % base
+		carry /= base
+	}
+
+	return trimLeadingZeroChunks(result)
+}
+
+// divModUint64 divides the magnitude, stored in chunks of the given base,
+// by an uint64 divisor and returns the remainder of the division.
+//
+// The quotient is written to quotient, which may be the magnitude itself to
+// divide in place, or nil when only the remainder is needed. The quotient
+// may have leading zero chunks, the caller is responsible for trimming them.
+func divModUint64(quotient, magnitude []uint64, base, divisor uint64) uint64 {
+	var remainder uint64
+
+	for idx, chunk := range magnitude {
+		// INFO: remainder < divisor, so the quotient always fits in a chunk
+		hi, lo := bits.Mul64(remainder, base)
+		lo, overflow := bits.Add64(lo, chunk, 0)
+
+		var digit uint64
+
+		digit, remainder = bits.Div64(hi+overflow, lo, divisor)
+
+		if quotient != nil {
+			quotient[idx] = digit
+		}
+	}
+
+	return remainder
+}
+
+// normalizeMagnitude propagates the carry of any chunk that doesn't fit in
+// the base and trims the leading zero chunks. The magnitude is returned as
+// is when every chunk already fits in the base.
+func normalizeMagnitude(magnitude []uint64, base uint64) []uint64 {
+	normalized := true
+
+	for _, chunk := range magnitude {
+		if chunk >= base {
+			normalized = false
+
+			break
+		}
+	}
+
+	if normalized {
+		return trimLeadingZeroChunks(magnitude)
+	}
+
+	// INFO: the carry is lower than 2^64 / 10, so the sum fits in two words
+	// and the chunks overflow at most one extra chunk of a base >= 10
+	result := make([]uint64, len(magnitude)+2)
+
+	var carry uint64
+
+	for idx := len(magnitude) - 1; idx >= 0; idx-- {
+		sum, overflow := bits.Add64(magnitude[idx], carry, 0)
+
+		carry, result[idx+2] = bits.Div64(overflow, sum, base)
+	}
+
+	result[0], result[1] = carry/base, carry%base
+
+	return normalizeMagnitude(result, base)
+}
+
+// cmpMagnitudes compares two magnitudes ignoring their leading zero chunks.
+func cmpMagnitudes(lhs, rhs []uint64) int {
+	lhs, rhs = trimLeadingZeroChunks(lhs), trimLeadingZeroChunks(rhs)
+
+	// The number with more chunks is the larger one
+	switch {
+	case len(lhs) < len(rhs):
+		return -1
+	case len(lhs) > len(rhs):
+		return 1
+	}
+
+	// Compare chunk by chunk starting from the most significant one
+	for idx := range lhs {
+		switch {
+		case lhs[idx] < rhs[idx]:
+			return -1
+		case lhs[idx] > rhs[idx]:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// magnitudeFromUint64 returns the magnitude of an uint64 value.
+func magnitudeFromUint64(value, base uint64) []uint64 {
+	if value < base {
+		return []uint64{value}
+	}
+
+	return mulMagnitudeUint64([]uint64{1}, base, value)
+}
+
+// quoRemMagnitudes divides lhs by rhs, both stored in chunks of the given base,
+// and returns the quotient and the remainder using the long division algorithm
+// described by Knuth (TAOCP Vol 2, 4.3.1, Algorithm D). The rhs must not be zero.
+func quoRemMagnitudes(lhs, rhs []uint64, base uint64) ([]uint64, []uint64) {
+	lhs, rhs = trimLeadingZeroChunks(lhs), trimLeadingZeroChunks(rhs)
+
+	if cmpMagnitudes(lhs, rhs) < 0 {
+		return []uint64{0}, append([]uint64(nil), lhs...)
+	}
+
+	// Single chunk divisors are solved with a short division
+	if len(rhs) == 1 {
+		quotient := make([]uint64, len(lhs))
+		remainder := divModUint64(quotient, lhs, base, rhs[0])
+
+		return trimLeadingZeroChunks(quotient), []uint64{remainder}
+	}
+
+	// Normalize both numbers so the leading chunk of the divisor is at least
+	// base/2, this keeps the estimated quotient digit off by at most two
+	factor := base / (rhs[0] + 1)
+
+	divisor := mulMagnitudeUint64(rhs, base, factor)
+	dividend := mulMagnitudeUint64(lhs, base, factor)
+
+	// INFO: the dividend needs an extra leading chunk for the first window
+	dividend = append([]uint64{0}, dividend...)
+
+	size := len(divisor)
+	quotient := make([]uint64, len(dividend)-size)
+
+	for j := range quotient {
+		// Estimate the quotient digit from the two leading chunks of the window
+		var qhat, rhat uint64
+
+		if dividend[j] < divisor[0] {
+			hi, lo := bits.Mul64(dividend[j], base)
+			lo, overflow := bits.Add64(lo, dividend[j+1], 0)
+
+			qhat, rhat = bits.Div64(hi+overflow, lo, divisor[0])
+		} else {
+			// INFO: the leading chunks are equal, the estimate would not fit in
+			// a chunk so it is capped to base-1 and the remainder adjusted to it
+			qhat, rhat = base-1, divisor[0]+dividend[j+1]
+		}
+
+		// Refine the estimate with the third chunk: qhat*divisor[1] > rhat*base + dividend[j+2]
+		for rhat < base {
+			productHi, productLo := bits.Mul64(qhat, divisor[1])
+
+			windowHi, windowLo := bits.Mul64(rhat, base)
+			windowLo, overflow := bits.Add64(windowLo, dividend[j+2], 0)
+			windowHi += overflow
+
+			if productHi < windowHi || productHi == windowHi && productLo <= windowLo {
+				break
+			}
+
+			qhat--
+			rhat += divisor[0]
+		}
+
+		// Multiply and subtract qhat * divisor from the window
+		var carry, borrow uint64
+
+		for k := size - 1; k >= 0; k-- {
+			hi, lo := bits.Mul64(qhat, divisor[k])
+			lo, overflow := bits.Add64(lo, carry, 0)
+
+			var digit uint64
+
+			carry, digit = bits.Div64(hi+overflow, lo, base)
+
+			value, subtrahend := dividend[j+k+1], digit+borrow
+			borrow = 0
+
+			if value < subtrahend {
+				value += base
+				borrow = 1
+			}
+
+			dividend[j+k+1] = value - subtrahend
+		}
+
+		top, subtrahend := dividend[j], carry+borrow
+
+		// The estimate was one too large, add the divisor back
+		if top < subtrahend {
+			qhat--
+
+			var carry uint64
+
+			for k := size - 1; k >= 0; k-- {
+				sum := dividend[j+k+1] + divisor[k] + carry
+				dividend[j+k+1] = sum % base
+				carry = sum / base
+			}
+
+			top = top + carry + base
+		}
+
+		dividend[j] = (top - subtrahend) % base
+		quotient[j] = qhat
+	}
+
+	// The remainder is the last window, undo the normalization
+	remainder := dividend[len(quotient):]
+	divModUint64(remainder, remainder, base, factor)
+
+	return trimLeadingZeroChunks(quotient), trimLeadingZeroChunks(remainder)
+}
+
+// sqrtMagnitude returns the floor of the square root of the magnitude
+// using the Newton's method.
+func sqrtMagnitude(magnitude []uint64, base uint64) []uint64 {
+	magnitude = trimLeadingZeroChunks(magnitude)
+
+	if isZeroMagnitude(magnitude) {
+		return []uint64{0}
+	}
+
+	// Start with base^ceil(chunks/2) which is always above the square root
+	estimate := make([]uint64, (len(magnitude)+1)/2+1)
+	estimate[0] = 1
+
+	for {
+		// next = (estimate + magnitude / estimate) / 2
+		quotient, _ := quoRemMagnitudes(magnitude, estimate, base)
+		next := addMagnitudes(estimate, quotient, base)
+		divModUint64(next, next, base, 2)
+		next = trimLeadingZeroChunks(next)
+
+		// The sequence decreases until it reaches the floor of the square root
+		if cmpMagnitudes(next, estimate) >= 0 {
+			return estimate
+		}
+
+		estimate = next
+	}
+}
+
+// rootMagnitude returns the floor of the nth root of the magnitude using
+// the Newton's method. n must be greater than one.
+func rootMagnitude(magnitude []uint64, n uint64, base uint64) []uint64 {
+	magnitude = trimLeadingZeroChunks(magnitude)
+
+	if isZeroMagnitude(magnitude) {
+		return []uint64{0}
+	}
+
+	// INFO: 2^n is above the magnitude, so the root is one
+	if n >= uint64(wordsBitLen(toWords(magnitude, base))) {
+		return []uint64{1}
+	}
+
+	estimate := rootEstimate(magnitude, n, base)
+
+	for {
+		// next = ((n - 1) * estimate + magnitude / estimate^(n-1)) / n
+		quotient, _ := quoRemMagnitudes(magnitude, powMagnitude(estimate, n-1, base), base)
+		next := addMagnitudes(mulMagnitudeUint64(estimate, base, n-1), quotient, base)
+		divModUint64(next, next, base, n)
+		next = trimLeadingZeroChunks(next)
+
+		// The sequence decreases until it reaches the floor of the nth root
+		if cmpMagnitudes(next, estimate) >= 0 {
+			return estimate
+		}
+
+		estimate = next
+	}
+}
+
+// rootEstimate returns a value slightly above the nth root of the non-zero
+// magnitude, computed with a float64 from its leading chunks.
+//
+// INFO: the Newton iterations of rootMagnitude only shrink a far estimate by
+// a factor of (n-1)/n on each step, so starting from an estimate with a relative
+// error around 10^-9 takes a few quadratic steps instead of O(n) steps.
+func rootEstimate(magnitude []uint64, n uint64, base uint64) []uint64 {
+	chunkSize := chunkDigits(base) - 1
+
+	// log10 of the magnitude from its leading chunks, the next chunks are
+	// rounded up so the logarithm is not below the one of the magnitude
+	leading, used := 0.0, 0
+	for ; used < len(magnitude) && leading < 1e17; used++ {
+		leading = leading*float64(base) + float64(magnitude[used])
+	}
+
+	if used < len(magnitude) {
+		leading++
+	}
+
+	logarithm := math.Log10(leading) + float64((len(magnitude)-used)*chunkSize)
+
+	// The margin keeps the estimate above the root despite the rounding errors
+	rootLog := logarithm/float64(n) + 1e-9
+
+	if rootLog < 17 {
+		return magnitudeFromUint64(uint64(math.Ceil(math.Pow(10, rootLog)))+1, base)
+	}
+
+	// INFO: the 18 leading digits of the root, followed by zeros
+	shift := int(rootLog) - 17
+	leadingDigits := uint64(math.Ceil(math.Pow(10, rootLog-float64(shift)))) + 1
+
+	estimate := magnitudeFromUint64(leadingDigits, base)
+	estimate = mulMagnitudeUint64(estimate, base, powersOfTen[shift%chunkSize])
+
+	return append(estimate, make([]uint64, shift/chunkSize)...)
+}
@@ -0,0 +1,52 @@
+package bignumber
+
+// KaratsubaThreshold is the number of chunks of the smallest operand from
+// which the multiplication goes through Karatsuba instead of the schoolbook
+// algorithm. It can be tuned for the target machine, but not while other
+// goroutines are multiplying. A threshold at or above the Toom-3 one disables
+// Karatsuba, since Toom-3 is tried first.
+//
+// INFO: the crossover measured by BenchmarkMulKaratsuba is around 20 chunks
+// (360 digits) against the schoolbook algorithm. Karatsuba is used from there
+// up to toomThreshold chunks.
+var KaratsubaThreshold = 24
+
+// mulMagnitudesKaratsuba multiplies two magnitudes using the Karatsuba
+// algorithm and stores the product in dst, which must be zeroed and have
+// exactly len(lhs)+len(rhs) chunks.
+func mulMagnitudesKaratsuba(dst, lhs, rhs []uint64, base uint64) {
+	// Make sure the larger magnitude is always on the left
+	if len(lhs) < len(rhs) {
+		lhs, rhs = rhs, lhs
+	}
+
+	size := (len(lhs) + 1) / 2
+	lhsHigh, lhsLow := lhs[:len(lhs)-size], lhs[len(lhs)-size:]
+
+	// INFO: when rhs fits in the low half, only lhs is split and the
+	// product is the sum of the two partial products
+	if len(rhs) <= size {
+		mulMagnitudesInto(dst[len(dst)-size-len(rhs):], lhsLow, rhs, base)
+		addMagnitudeInPlace(dst[:len(dst)-size], mulMagnitudes(lhsHigh, rhs, base), base)
+
+		return
+	}
+
+	rhsHigh, rhsLow := rhs[:len(rhs)-size], rhs[len(rhs)-size:]
+
+	// The low and high products don't overlap, so they go straight into dst
+	low, high := dst[len(dst)-2*size:], dst[:len(dst)-2*size]
+
+	mulMagnitudesInto(low, lhsLow, rhsLow, base)
+	mulMagnitudesInto(high, lhsHigh, rhsHigh, base)
+
+	// middle = (lhsHigh + lhsLow) * (rhsHigh + rhsLow) - low - high
+	lhsSum := trimLeadingZeroChunks(addMagnitudes(lhsHigh, lhsLow, base))
+	rhsSum := trimLeadingZeroChunks(addMagnitudes(rhsHigh, rhsLow, base))
+
+	middle := mulMagnitudes(lhsSum, rhsSum, base)
+	middle = subMagnitudes(middle, trimLeadingZeroChunks(low), base)
+	middle = subMagnitudes(middle, trimLeadingZeroChunks(high), base)
+
+	addMagnitudeInPlace(dst[:len(dst)-size], middle, base)
+}
@@ -0,0 +1,73 @@
+package bignumber
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestMulMagnitudesKaratsuba(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+
+	sizes := [][2]int{{1, 1}, {10, 10}, {100, 3}, {400, 400}, {1000, 1000}, {2000, 500}}
+	for range 30 {
+		sizes = append(sizes, [2]int{1 + random.Intn(2000), 1 + random.Intn(2000)})
+	}
+
+	for idx, size := range sizes {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			lhs, rhs := randomNumber(random, size[0]), randomNumber(random, size[1])
+
+			lhsInt, _ := new(big.Int).SetString(lhs, 10)
+			rhsInt, _ := new(big.Int).SetString(rhs, 10)
+			want := new(big.Int).Mul(lhsInt, rhsInt).String()
+
+			lhsMagnitude, rhsMagnitude := MustNewBigInt(lhs).magnitude, MustNewBigInt(rhs).magnitude
+			product := make([]uint64, len(lhsMagnitude)+len(rhsMagnitude))
+
+			mulMagnitudesKaratsuba(product, lhsMagnitude, rhsMagnitude, powersOfTen[maxChunkSize])
+
+			if got := newBigIntFromMagnitude(product, maxChunkSize).String(); got != want {
+				t.Errorf("got %v digits, want %v digits", len(got), len(want))
+			}
+		})
+	}
+}
+
+func TestBigIntMulKaratsubaThreshold(t *testing.T) {
+	defer func(threshold int) { KaratsubaThreshold = threshold }(KaratsubaThreshold)
+
+	random := rand.New(rand.NewSource(4))
+	lhs, rhs := randomNumber(random, 700), randomNumber(random, 500)
+
+	lhsInt, _ := new(big.Int).SetString(lhs, 10)
+	rhsInt, _ := new(big.Int).SetString(rhs, 10)
+	want := new(big.Int).Mul(lhsInt, rhsInt).String()
+
+	// INFO: 1 recurses down to single chunks, the Toom-3 threshold disables Karatsuba
+	for idx, threshold := range []int{1, 2, 5, toomThreshold} {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			KaratsubaThreshold = threshold
+
+			for chunkSize := 1; chunkSize <= maxChunkSize; chunkSize += 4 {
+				got := MustNewBigInt(lhs, WithChunkSize(chunkSize)).Mul(MustNewBigInt(rhs))
+				if got.String() != want {
+					t.Errorf("chunk size %d: got %v digits, want %v digits", chunkSize, len(got.String()), len(want))
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkMulKaratsuba(b *testing.B) {
+	for _, digits := range []int{360, 720, 1000, 2000, 10000} {
+		b.Run(fmt.Sprintf("digits=%d", digits), func(b *testing.B) {
+			benchmarkMul(b, digits, mulMagnitudesKaratsuba)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package bignumber
+
+import "math/bits"
+
+// The number theoretic transform (NTT) multiplication works modulo the prime
+// p = 2^64 - 2^32 + 1, which has roots of unity for every power of two up to
+// 2^32 and allows a fast reduction of the 128 bits products.
+const (
+	// nttPrime is the prime modulus of the transform.
+	nttPrime = 0xFFFFFFFF00000001
+	// nttEpsilon is 2^64 mod nttPrime, which is 2^32 - 1.
+	nttEpsilon = 0xFFFFFFFF
+	// nttGenerator generates the multiplicative group modulo nttPrime.
+	nttGenerator = 7
+)
+
+// nttThreshold is the number of chunks of the smallest operand from which
+// the multiplication goes through the NTT instead of Toom-3.
+//
+// INFO: the crossover measured by BenchmarkMulNTT against Toom-3 is
+// between 5000 and 8000 chunks (90000 to 150000 digits), the transform
+// length doubling makes the NTT timings go in steps.
+const nttThreshold = 8000
+
+// nttMul returns a*b mod nttPrime, a and b must be lower than nttPrime.
+func nttMul(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+
+	// INFO: 2^64 = 2^32 - 1 and 2^96 = -1 modulo the prime, so
+	// hi*2^64 + lo = lo + (hi mod 2^32)*(2^32 - 1) - hi/2^32
+	result, borrow := bits.Sub64(lo, hi>>32, 0)
+	if borrow != 0 {
+		result -= nttEpsilon
+	}
+
+	result, carry := bits.Add64(result, (hi&nttEpsilon)*nttEpsilon, 0)
+	if carry != 0 {
+		result += nttEpsilon
+	}
+
+	if result >= nttPrime {
+		result -= nttPrime
+	}
+
+	return result
+}
+
+// nttAdd returns a+b mod nttPrime, a and b must be lower than nttPrime.
+func nttAdd(a, b uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+
+	// INFO: when the sum overflows, the wrapping subtraction gives the right value
+	if carry != 0 || sum >= nttPrime {
+		sum -= nttPrime
+	}
+
+	return sum
+}
+
+// nttSub returns a-b mod nttPrime, a and b must be lower than nttPrime.
+func nttSub(a, b uint64) uint64 {
+	difference, borrow := bits.Sub64(a, b, 0)
+	if borrow != 0 {
+		difference += nttPrime
+	}
+
+	return difference
+}
+
+// nttPow returns base^exponent mod nttPrime.
+func nttPow(base, exponent uint64) uint64 {
+	result := uint64(1)
+
+	for ; exponent > 0; exponent >>= 1 {
+		if exponent&1 == 1 {
+			result = nttMul(result, base)
+		}
+
+		base = nttMul(base, base)
+	}
+
+	return result
+}
+
+// ntt transforms the values in place, their length must be a power of two.
+// When inverse is true the inverse transform is applied, including the
+// division by the length.
+func ntt(values []uint64, inverse bool) {
+	size := len(values)
+
+	// Reorder the values by the bit reversal of their index
+	for i, j := 1, 0; i < size; i++ {
+		bit := size >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+
+		j ^= bit
+
+		if i < j {
+			values[i], values[j] = values[j], values[i]
+		}
+	}
+
+	for length := 2; length <= size; length <<= 1 {
+		// INFO: the root has an order of length, since the group has nttPrime-1 elements
+		root := nttPow(nttGenerator, (nttPrime-1)/uint64(length))
+		if inverse {
+			root = nttPow(root, nttPrime-2)
+		}
+
+		half := length / 2
+
+		// Precompute the powers of the root used by every block
+		twiddles := make([]uint64, half)
+		twiddles[0] = 1
+
+		for k := 1; k < half; k++ {
+			twiddles[k] = nttMul(twiddles[k-1], root)
+		}
+
+		for start := 0; start < size; start += length {
+			for k := 0; k < half; k++ {
+				even := values[start+k]
+				odd := nttMul(values[start+k+half], twiddles[k])
+
+				values[start+k] = nttAdd(even, odd)
+				values[start+k+half] = nttSub(even, odd)
+			}
+		}
+	}
+
+	if inverse {
+		scale := nttPow(uint64(size), nttPrime-2)
+
+		for idx := range values {
+			values[idx] = nttMul(values[idx], scale)
+		}
+	}
+}
+
+// nttDigitBase returns the base of the digits the chunks are split into,
+// every coefficient of the convolution must fit below nttPrime.
+func nttDigitBase(base uint64) uint64 {
+	// INFO: the chunks are split in groups of 3 decimal digits when the
+	// chunk size allows it, otherwise they are split digit by digit
+	for rest := base; rest > 1; rest /= 1000 {
+		if rest%1000 != 0 {
+			return 10
+		}
+	}
+
+	return 1000
+}
+
+// nttDigits splits the magnitude into digits of the given base,
+// from the least significant to the most significant one.
+func nttDigits(magnitude []uint64, base, digitBase uint64, size int) []uint64 {
+	digits := make([]uint64, size)
+
+	idx := 0
+
+	for chunkIdx := len(magnitude) - 1; chunkIdx >= 0; chunkIdx-- {
+		chunk := magnitude[chunkIdx]
+
+		for power := uint64(1); power < base; power *= digitBase {
+			digits[idx] = chunk % digitBase
+			chunk /= digitBase
+			idx++
+		}
+	}
+
+	return digits
+}
+
+// mulMagnitudesNTT multiplies two magnitudes using the number theoretic
+// transform and stores the product in dst, which must have exactly
+// len(lhs)+len(rhs) chunks.
+func mulMagnitudesNTT(dst, lhs, rhs []uint64, base uint64) {
+	digitBase := nttDigitBase(base)
+
+	digitsPerChunk := 0
+	for power := uint64(1); power < base; power *= digitBase {
+		digitsPerChunk++
+	}
+
+	// The transform length must hold the whole product
+	size := 1
+	for size < (len(lhs)+len(rhs))*digitsPerChunk {
+		size <<= 1
+	}
+
+	lhsDigits := nttDigits(lhs, base, digitBase, size)
+	rhsDigits := nttDigits(rhs, base, digitBase, size)
+
+	ntt(lhsDigits, false)
+	ntt(rhsDigits, false)
+
+	for idx := range lhsDigits {
+		lhsDigits[idx] = nttMul(lhsDigits[idx], rhsDigits[idx])
+	}
+
+	ntt(lhsDigits, true)
+
+	// Propagate the carries and pack the digits back into chunks
+	var carry uint64
+
+	idx := 0
+
+	for chunkIdx := len(dst) - 1; chunkIdx >= 0; chunkIdx-- {
+		var chunk uint64
+
+		for power := uint64(1); power < base; power *= digitBase {
+			value := lhsDigits[idx] + carry
+
+			chunk += value % digitBase * power
+			carry = value / digitBase
+			idx++
+		}
+
+		dst[chunkIdx] = chunk
+	}
+}
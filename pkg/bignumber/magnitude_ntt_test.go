@@ -0,0 +1,155 @@
+package bignumber
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// randomNumber returns a random number with the given number of digits.
+func randomNumber(random *rand.Rand, size int) string {
+	var builder strings.Builder
+
+	builder.WriteByte(byte('1' + random.Intn(9)))
+
+	for builder.Len() < size {
+		// INFO: runs of nines and zeros stress the carries
+		switch random.Intn(4) {
+		case 0:
+			builder.WriteByte('9')
+		case 1:
+			builder.WriteByte('0')
+		default:
+			builder.WriteByte(byte('0' + random.Intn(10)))
+		}
+	}
+
+	return builder.String()
+}
+
+func TestNTTMul(t *testing.T) {
+	prime := new(big.Int).SetUint64(nttPrime)
+	random := rand.New(rand.NewSource(1))
+
+	values := []uint64{0, 1, 2, nttEpsilon, nttEpsilon + 1, nttPrime - 2, nttPrime - 1}
+	for range 1000 {
+		values = append(values, random.Uint64()%nttPrime)
+	}
+
+	for idx := 0; idx+1 < len(values); idx++ {
+		a, b := values[idx], values[len(values)-1-idx]
+
+		want := new(big.Int).Mul(new(big.Int).SetUint64(a), new(big.Int).SetUint64(b))
+		want.Mod(want, prime)
+
+		if got := nttMul(a, b); got != want.Uint64() {
+			t.Fatalf("got %v, want %v for %v * %v", got, want, a, b)
+		}
+	}
+}
+
+func TestMulMagnitudesNTT(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	sizes := [][2]int{{1, 1}, {9, 9}, {10, 1}, {100, 100}, {1000, 37}, {5000, 5000}, {20000, 12345}}
+	for range 20 {
+		sizes = append(sizes, [2]int{1 + random.Intn(3000), 1 + random.Intn(3000)})
+	}
+
+	for idx, size := range sizes {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			lhs, rhs := randomNumber(random, size[0]), randomNumber(random, size[1])
+
+			lhsInt, _ := new(big.Int).SetString(lhs, 10)
+			rhsInt, _ := new(big.Int).SetString(rhs, 10)
+			want := new(big.Int).Mul(lhsInt, rhsInt).String()
+
+			lhsMagnitude, rhsMagnitude := MustNewBigInt(lhs).magnitude, MustNewBigInt(rhs).magnitude
+			product := make([]uint64, len(lhsMagnitude)+len(rhsMagnitude))
+
+			mulMagnitudesNTT(product, lhsMagnitude, rhsMagnitude, powersOfTen[maxChunkSize])
+
+			if got := newBigIntFromMagnitude(product, maxChunkSize).String(); got != want {
+				t.Errorf("got %v digits, want %v digits", len(got), len(want))
+			}
+		})
+	}
+}
+
+func TestMulMagnitudesNTTSmallChunks(t *testing.T) {
+	// INFO: chunks of 4 digits can't be split in groups of 3 digits
+	lhs := &BigInt{magnitude: []uint64{1234, 5678, 9012}, chukSize: 4}
+	rhs := &BigInt{magnitude: []uint64{9999, 9999}, chukSize: 4}
+
+	product := make([]uint64, 5)
+	mulMagnitudesNTT(product, lhs.magnitude, rhs.magnitude, lhs.base())
+
+	if got, want := newBigIntFromMagnitude(product, 4).String(), "12345678777743210988"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestBigIntMulLarge(t *testing.T) {
+	lhs := strings.Repeat("123456789", 2*nttThreshold)
+	rhs := strings.Repeat("987654321", 2*nttThreshold)
+
+	lhsInt, _ := new(big.Int).SetString(lhs, 10)
+	rhsInt, _ := new(big.Int).SetString(rhs, 10)
+
+	if got, want := MustNewBigInt(lhs).Mul(MustNewBigInt(rhs)).String(), new(big.Int).Mul(lhsInt, rhsInt).String(); got != want {
+		t.Errorf("got %v digits, want %v digits", len(got), len(want))
+	}
+}
+
+// benchmarkMul benchmarks the multiplication of two numbers with the given digits.
+func benchmarkMul(b *testing.B, digits int, mul func(dst, lhs, rhs []uint64, base uint64)) {
+	lhs := MustNewBigInt(strings.Repeat("1234567890", digits/10)).magnitude
+	rhs := MustNewBigInt(strings.Repeat("9876543210", digits/10)).magnitude
+	base := powersOfTen[maxChunkSize]
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		mul(make([]uint64, len(lhs)+len(rhs)), lhs, rhs, base)
+	}
+}
+
+// schoolbookMul is the schoolbook multiplication without the NTT dispatch.
+func schoolbookMul(dst, lhs, rhs []uint64, base uint64) {
+	for i := len(lhs) - 1; i >= 0; i-- {
+		var carry uint64
+
+		for j := len(rhs) - 1; j >= 0; j-- {
+			hi, lo := bits.Mul64(lhs[i], rhs[j])
+			lo, overflow := bits.Add64(lo, dst[i+j+1], 0)
+			hi += overflow
+			lo, overflow = bits.Add64(lo, carry, 0)
+			hi += overflow
+
+			carry, dst[i+j+1] = bits.Div64(hi, lo, base)
+		}
+
+		dst[i] = carry
+	}
+}
+
+func BenchmarkMulSchoolbook(b *testing.B) {
+	for _, digits := range []int{1000, 10000, 100000} {
+		b.Run(fmt.Sprintf("digits=%d", digits), func(b *testing.B) {
+			benchmarkMul(b, digits, schoolbookMul)
+		})
+	}
+}
+
+func BenchmarkMulNTT(b *testing.B) {
+	for _, digits := range []int{1000, 10000, 100000} {
+		b.Run(fmt.Sprintf("digits=%d", digits), func(b *testing.B) {
+			benchmarkMul(b, digits, mulMagnitudesNTT)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package bignumber
+
+// toomThreshold is the number of chunks of the smallest operand from which
+// the multiplication goes through Toom-3 instead of Karatsuba.
+//
+// INFO: the crossover measured by BenchmarkMulToom3 is around 40 chunks
+// (720 digits) against the schoolbook algorithm, but around 90 chunks
+// (1620 digits) against Karatsuba. Toom-3 is used from there up to
+// nttThreshold chunks, where the NTT takes over.
+const toomThreshold = 100
+
+// signedMagnitude is a magnitude with a sign, the Toom-3 evaluation
+// and interpolation steps go through negative values.
+type signedMagnitude struct {
+	magnitude []uint64
+	negative  bool
+}
+
+// addSigned returns lhs + rhs, both stored in chunks of the given base.
+func addSigned(lhs, rhs signedMagnitude, base uint64) signedMagnitude {
+	if lhs.negative == rhs.negative {
+		return signedMagnitude{addMagnitudes(lhs.magnitude, rhs.magnitude, base), lhs.negative}
+	}
+
+	// INFO: with different signs the smallest magnitude is subtracted from the largest one
+	if cmpMagnitudes(lhs.magnitude, rhs.magnitude) < 0 {
+		lhs, rhs = rhs, lhs
+	}
+
+	magnitude := subMagnitudes(lhs.magnitude, rhs.magnitude, base)
+
+	return signedMagnitude{magnitude, lhs.negative && !isZeroMagnitude(magnitude)}
+}
+
+// subSigned returns lhs - rhs, both stored in chunks of the given base.
+func subSigned(lhs, rhs signedMagnitude, base uint64) signedMagnitude {
+	rhs.negative = !rhs.negative
+
+	return addSigned(lhs, rhs, base)
+}
+
+// mulSignedUint64 returns value * factor.
+func mulSignedUint64(value signedMagnitude, factor, base uint64) signedMagnitude {
+	return signedMagnitude{mulMagnitudeUint64(value.magnitude, base, factor), value.negative}
+}
+
+// divExactSigned returns value / divisor, the division must be exact.
+func divExactSigned(value signedMagnitude, divisor, base uint64) signedMagnitude {
+	quotient := make([]uint64, len(value.magnitude))
+	divModUint64(quotient, value.magnitude, base, divisor)
+
+	return signedMagnitude{trimLeadingZeroChunks(quotient), value.negative}
+}
+
+// toomSplit splits the magnitude in three parts of size chunks,
+// from the least significant to the most significant one.
+func toomSplit(magnitude []uint64, size int) [3]signedMagnitude {
+	var parts [3]signedMagnitude
+
+	for idx := range parts {
+		end := max(len(magnitude)-idx*size, 0)
+		start := max(end-size, 0)
+
+		// INFO: the most significant part takes the rest of the chunks
+		if idx == len(parts)-1 {
+			start = 0
+		}
+
+		parts[idx] = signedMagnitude{magnitude: trimLeadingZeroChunks(magnitude[start:end])}
+	}
+
+	return parts
+}
+
+// toomEvaluate evaluates the polynomial with the given coefficients
+// at the points 0, 1, -1, -2 and infinity.
+func toomEvaluate(parts [3]signedMagnitude, base uint64) [5]signedMagnitude {
+	// x0 + x2 is shared by the evaluations at 1 and -1
+	even := addSigned(parts[0], parts[2], base)
+
+	atOne := addSigned(even, parts[1], base)
+	atMinusOne := subSigned(even, parts[1], base)
+
+	// p(-2) = (p(-1) + x2) * 2 - x0
+	atMinusTwo := subSigned(mulSignedUint64(addSigned(atMinusOne, parts[2], base), 2, base), parts[0], base)
+
+	return [5]signedMagnitude{parts[0], atOne, atMinusOne, atMinusTwo, parts[2]}
+}
+
+// mulMagnitudesToom3 multiplies two magnitudes using the Toom-Cook 3-way
+// algorithm and stores the product in dst, which must have exactly
+// len(lhs)+len(rhs) chunks.
+func mulMagnitudesToom3(dst, lhs, rhs []uint64, base uint64) {
+	size := (max(len(lhs), len(rhs)) + 2) / 3
+
+	lhsPoints := toomEvaluate(toomSplit(lhs, size), base)
+	rhsPoints := toomEvaluate(toomSplit(rhs, size), base)
+
+	// Multiply pointwise, the products go back through the dispatch
+	var products [5]signedMagnitude
+
+	for idx := range products {
+		products[idx] = signedMagnitude{
+			magnitude: mulMagnitudes(lhsPoints[idx].magnitude, rhsPoints[idx].magnitude, base),
+			negative:  lhsPoints[idx].negative != rhsPoints[idx].negative,
+		}
+	}
+
+	// Interpolate the coefficients of the product using the sequence by Bodrato
+	r0, r1, rm1, rm2, rinf := products[0], products[1], products[2], products[3], products[4]
+
+	r3 := divExactSigned(subSigned(rm2, r1, base), 3, base)
+	r1 = divExactSigned(subSigned(r1, rm1, base), 2, base)
+	r2 := subSigned(rm1, r0, base)
+	r3 = addSigned(divExactSigned(subSigned(r2, r3, base), 2, base), mulSignedUint64(rinf, 2, base), base)
+	r2 = subSigned(addSigned(r2, r1, base), rinf, base)
+	r1 = subSigned(r1, r3, base)
+
+	// INFO: the coefficients of the product are never negative, so they are
+	// added shifted by their power of base^size from the least significant one
+	coefficients := [5]signedMagnitude{r0, r1, r2, r3, rinf}
+
+	for idx, coefficient := range coefficients {
+		shift := idx * size
+		magnitude := trimLeadingZeroChunks(coefficient.magnitude)
+
+		if isZeroMagnitude(magnitude) {
+			continue
+		}
+
+		end := len(dst) - shift
+		addMagnitudeInPlace(dst[:end], magnitude, base)
+	}
+}
@@ -0,0 +1,46 @@
+package bignumber
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestMulMagnitudesToom3(t *testing.T) {
+	random := rand.New(rand.NewSource(2))
+
+	sizes := [][2]int{{1, 1}, {10, 10}, {100, 3}, {1000, 1000}, {5000, 4000}}
+	for range 30 {
+		sizes = append(sizes, [2]int{1 + random.Intn(4000), 1 + random.Intn(4000)})
+	}
+
+	for idx, size := range sizes {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			lhs, rhs := randomNumber(random, size[0]), randomNumber(random, size[1])
+
+			lhsInt, _ := new(big.Int).SetString(lhs, 10)
+			rhsInt, _ := new(big.Int).SetString(rhs, 10)
+			want := new(big.Int).Mul(lhsInt, rhsInt).String()
+
+			lhsMagnitude, rhsMagnitude := MustNewBigInt(lhs).magnitude, MustNewBigInt(rhs).magnitude
+			product := make([]uint64, len(lhsMagnitude)+len(rhsMagnitude))
+
+			mulMagnitudesToom3(product, lhsMagnitude, rhsMagnitude, powersOfTen[maxChunkSize])
+
+			if got := newBigIntFromMagnitude(product, maxChunkSize).String(); got != want {
+				t.Errorf("got %v digits, want %v digits", len(got), len(want))
+			}
+		})
+	}
+}
+
+func BenchmarkMulToom3(b *testing.B) {
+	for _, digits := range []int{1000, 10000, 100000} {
+		b.Run(fmt.Sprintf("digits=%d", digits), func(b *testing.B) {
+			benchmarkMul(b, digits, mulMagnitudesToom3)
+		})
+	}
+}
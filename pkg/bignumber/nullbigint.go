@@ -0,0 +1,57 @@
+package bignumber
+
+import (
+	"database/sql/driver"
+	"strconv"
+)
+
+// NullBigInt represents a BigInt that may be NULL, it implements
+// the sql.Scanner and driver.Valuer interfaces like `sql.NullInt64`.
+type NullBigInt struct {
+	// BigInt is the value, it is nil when the value is NULL.
+	BigInt *BigInt
+	// Valid is true if BigInt is not NULL.
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+// The supported source types are nil, string, []byte and int64.
+func (n *NullBigInt) Scan(src any) error {
+	var (
+		bigInt *BigInt
+		err    error
+	)
+
+	switch value := src.(type) {
+	case nil:
+		n.BigInt, n.Valid = nil, false
+
+		return nil
+	case string:
+		bigInt, err = NewBigInt(value)
+	case []byte:
+		bigInt, err = NewBigInt(string(value))
+	case int64:
+		bigInt, err = NewBigInt(strconv.FormatInt(value, 10))
+	default:
+		return ErrUnsupportedScanType
+	}
+
+	if err != nil {
+		return err
+	}
+
+	n.BigInt, n.Valid = bigInt, true
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface, the BigInt
+// is stored as its decimal string representation.
+func (n NullBigInt) Value() (driver.Value, error) {
+	if !n.Valid || n.BigInt == nil {
+		return nil, nil
+	}
+
+	return n.BigInt.String(), nil
+}
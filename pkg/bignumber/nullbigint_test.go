@@ -0,0 +1,234 @@
+package bignumber
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"testing"
+)
+
+// fakeDriver is a database driver that returns the rows of its data source,
+// where every row has a single column.
+type fakeDriver struct {
+	rows []driver.Value
+}
+
+func (d fakeDriver) Open(string) (driver.Conn, error) {
+	return fakeConn(d), nil
+}
+
+type fakeConn fakeDriver
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) {
+	return fakeStmt(c), nil
+}
+
+func (c fakeConn) Close() error {
+	return nil
+}
+
+func (c fakeConn) Begin() (driver.Tx, error) {
+	return nil, driver.ErrSkip
+}
+
+type fakeStmt fakeDriver
+
+func (s fakeStmt) Close() error {
+	return nil
+}
+
+func (s fakeStmt) NumInput() int {
+	return 0
+}
+
+func (s fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, driver.ErrSkip
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{rows: s.rows}, nil
+}
+
+type fakeRows struct {
+	rows []driver.Value
+	next int
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+
+	dest[0] = r.rows[r.next]
+	r.next++
+
+	return nil
+}
+
+func init() {
+	// INFO: drivers can only be registered once, so it can't be done
+	// inside the test or running it with -count > 1 would panic
+	sql.Register("bignumber-fake", fakeDriver{
+		rows: []driver.Value{
+			nil,
+			"123456789012345678901234567890",
+			[]byte("1000000000"),
+			int64(42),
+			int64(-42),
+		},
+	})
+}
+
+func TestNullBigIntScanFromDatabase(t *testing.T) {
+	db, err := sql.Open("bignumber-fake", "")
+	if err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT value")
+	if err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+	defer rows.Close()
+
+	var got []NullBigInt
+
+	for rows.Next() {
+		var value NullBigInt
+
+		if err := rows.Scan(&value); err != nil {
+			t.Fatalf("got %v, want nil", err)
+		}
+
+		got = append(got, value)
+	}
+
+	if err := rows.Err(); err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+
+	want := []struct {
+		value string
+		valid bool
+	}{
+		{
+			value: "",
+			valid: false,
+		},
+		{
+			value: "123456789012345678901234567890",
+			valid: true,
+		},
+		{
+			value: "1000000000",
+			valid: true,
+		},
+		{
+			value: "42",
+			valid: true,
+		},
+		{
+			value: "-42",
+			valid: true,
+		},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v rows, want %v", len(got), len(want))
+	}
+
+	for idx, tc := range want {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got[idx].Valid != tc.valid {
+				t.Errorf("got %v, want %v", got[idx].Valid, tc.valid)
+			}
+
+			if tc.valid && got[idx].BigInt.String() != tc.value {
+				t.Errorf("got %v, want %v", got[idx].BigInt.String(), tc.value)
+			}
+
+			if !tc.valid && got[idx].BigInt != nil {
+				t.Errorf("got %v, want nil", got[idx].BigInt)
+			}
+		})
+	}
+}
+
+func TestNullBigIntScanErrors(t *testing.T) {
+	tests := []struct {
+		input any
+		err   error
+	}{
+		{
+			input: "abc",
+			err:   ErrConvertingChunkToInteger,
+		},
+		{
+			input: 1.5,
+			err:   ErrUnsupportedScanType,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			var value NullBigInt
+
+			if err := value.Scan(tc.input); err != tc.err {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+
+			if value.Valid {
+				t.Errorf("got %v, want %v", value.Valid, false)
+			}
+		})
+	}
+}
+
+func TestNullBigIntValue(t *testing.T) {
+	tests := []struct {
+		input NullBigInt
+		want  driver.Value
+	}{
+		{
+			input: NullBigInt{},
+			want:  nil,
+		},
+		{
+			input: NullBigInt{BigInt: MustNewBigInt("123"), Valid: true},
+			want:  "123",
+		},
+		{
+			input: NullBigInt{BigInt: MustNewBigInt("-123"), Valid: true},
+			want:  "-123",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := tc.input.Value()
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
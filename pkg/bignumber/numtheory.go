@@ -0,0 +1,500 @@
+package bignumber
+
+import (
+	"math"
+	"math/bits"
+	"math/rand"
+	"slices"
+)
+
+// Factorize returns the prime factors of the BigInt with multiplicity
+// in ascending order, Ex: 360 returns [2, 2, 2, 3, 3, 5].
+//
+// Factorizing 1 returns an empty slice and factorizing 0 returns ErrFactorizingZero.
+// The factors of a negative BigInt are the ones of its absolute value, Ex: -12
+// returns [2, 2, 3].
+//
+// INFO: This uses trial division up to the square root of the number, so it is
+// only practical when every prime factor, but the largest one, is small
+// (roughly below 10^8) and the largest one fits in an uint64. Numbers with
+// two big prime factors, or a prime factor above 2^64, will take forever.
+func (b *BigInt) Factorize() ([]*BigInt, error) {
+	b = b.orZero()
+
+	base := b.base()
+
+	// Work on a copy since the factors are removed in place
+	normalized := normalizeMagnitude(b.magnitude, base)
+	work := make([]uint64, len(normalized))
+	copy(work, normalized)
+
+	if isZeroMagnitude(work) {
+		return nil, ErrFactorizingZero
+	}
+
+	factors := []*BigInt{}
+	divisor := uint64(2)
+	maxUint64 := magnitudeFromUint64(math.MaxUint64, base)
+
+	// Peel the factors off the big number until it fits in an uint64
+	for cmpMagnitudes(work, maxUint64) > 0 {
+		// INFO: work > 2^64, so divisor^2 <= work while divisor < 2^32
+		if divisor > math.MaxUint32 {
+			squared := mulMagnitudeUint64(magnitudeFromUint64(divisor, base), base, divisor)
+
+			if cmpMagnitudes(squared, work) > 0 {
+				break
+			}
+		}
+
+		if divModUint64(nil, work, base, divisor) != 0 {
+			divisor = nextTrialDivisor(divisor)
+
+			continue
+		}
+
+		divModUint64(work, work, base, divisor)
+		work = trimLeadingZeroChunks(work)
+
+		factors = append(factors, newBigIntFromMagnitude(magnitudeFromUint64(divisor, base), b.chunkSize()))
+	}
+
+	// The remaining number is either a prime or fits in an uint64
+	value, err := newBigIntFromMagnitude(work, b.chunkSize()).ToUint64()
+	if err != nil {
+		return append(factors, newBigIntFromMagnitude(work, b.chunkSize())), nil
+	}
+
+	for ; divisor <= value/divisor; divisor = nextTrialDivisor(divisor) {
+		for value%divisor == 0 {
+			factors = append(factors, newBigIntFromMagnitude(magnitudeFromUint64(divisor, base), b.chunkSize()))
+			value /= divisor
+		}
+	}
+
+	if value > 1 {
+		factors = append(factors, newBigIntFromMagnitude(magnitudeFromUint64(value, base), b.chunkSize()))
+	}
+
+	return factors, nil
+}
+
+// nextTrialDivisor returns the next candidate divisor for trial division,
+// after 2 only odd numbers are tried.
+func nextTrialDivisor(divisor uint64) uint64 {
+	if divisor == 2 {
+		return 3
+	}
+
+	return divisor + 2
+}
+
+// CollatzSteps returns the number of steps the Collatz process takes to reach 1,
+// on each step the number is halved when even or replaced by 3n+1 when odd.
+//
+// The process is only defined for the positive values and 1 is already
+// there, so every value below 2 returns 0 steps: 0 halves to itself and the
+// negative values fall in cycles like -1, -2, -1 that never reach 1. A nil
+// BigInt is zero.
+func (b *BigInt) CollatzSteps() uint64 {
+	b = b.orZero()
+
+	var steps uint64
+
+	for value := b; value.CmpInt64(1) > 0; steps++ {
+		if value.IsEven() {
+			value = value.Half()
+		} else {
+			value = value.MulScalar(3).Inc()
+		}
+	}
+
+	return steps
+}
+
+// GCD returns the greatest common divisor of the BigInt and other using the
+// Euclidean algorithm, Ex: the GCD of 12 and 18 is 6. The result is never
+// negative, and the GCD of 0 and 0 is 0.
+func (b *BigInt) GCD(other *BigInt) *BigInt {
+	b = b.orZero()
+
+	// A nil operand is treated as zero
+	other = other.inChunksOf(b.chunkSize())
+
+	base := b.base()
+	lhs, rhs := normalizeMagnitude(b.magnitude, base), normalizeMagnitude(other.magnitude, base)
+
+	// INFO: every step replaces the pair by (rhs, lhs mod rhs), the
+	// remainder shrinks so it reaches zero with lhs as the divisor
+	for !isZeroMagnitude(rhs) {
+		_, remainder := quoRemMagnitudes(lhs, rhs, base)
+		lhs, rhs = rhs, remainder
+	}
+
+	// The magnitude may still be the one of an operand
+	return newBigIntFromMagnitude(slices.Clone(lhs), b.chunkSize())
+}
+
+// BinaryGCD returns the same result as GCD using the binary GCD algorithm
+// (Stein's algorithm), it only halves the even values, subtracts the smallest
+// odd value from the largest one and doubles the result back by the common
+// factors of two.
+//
+// INFO: it skips the long divisions of GCD and works in place, the
+// benchmarks measure it 2.5 to 5 times faster from 40 to 5000 digits.
+func (b *BigInt) BinaryGCD(other *BigInt) *BigInt {
+	b = b.orZero()
+
+	// A nil operand is treated as zero
+	other = other.inChunksOf(b.chunkSize())
+
+	base, chunkSize := b.base(), b.chunkSize()
+
+	// Work on copies since the values are halved and subtracted in place
+	lhs := slices.Clone(normalizeMagnitude(b.magnitude, base))
+	rhs := slices.Clone(normalizeMagnitude(other.magnitude, base))
+
+	switch {
+	case isZeroMagnitude(lhs):
+		return newBigIntFromMagnitude(rhs, chunkSize)
+	case isZeroMagnitude(rhs):
+		return newBigIntFromMagnitude(lhs, chunkSize)
+	}
+
+	// The common factors of two are part of the result
+	var shift int
+
+	for {
+		common := min(trailingZeroBits(lhs, chunkSize), trailingZeroBits(rhs, chunkSize))
+		if common == 0 {
+			break
+		}
+
+		lhs, rhs = halveMagnitude(lhs, base, common), halveMagnitude(rhs, base, common)
+		shift += common
+	}
+
+	lhs = removeFactorsOfTwo(lhs, base, chunkSize)
+
+	// INFO: lhs stays odd, so the factors of two left in rhs are not common
+	for {
+		rhs = removeFactorsOfTwo(rhs, base, chunkSize)
+
+		if cmpMagnitudes(lhs, rhs) > 0 {
+			lhs, rhs = rhs, lhs
+		}
+
+		subMagnitudeInPlace(rhs, lhs, base)
+		rhs = trimLeadingZeroChunks(rhs)
+
+		if isZeroMagnitude(rhs) {
+			break
+		}
+	}
+
+	// Double the result back by the common factors of two
+	for ; shift > 0; shift -= 63 {
+		lhs = mulMagnitudeUint64(lhs, base, 1<<min(shift, 63))
+	}
+
+	return newBigIntFromMagnitude(lhs, chunkSize)
+}
+
+// GCD returns the greatest common divisor of a and b, it is the same as
+// a.BinaryGCD(b). The result is never negative, and the GCD of 0 and 0 is 0.
+func GCD(a, b *BigInt) *BigInt {
+	return a.BinaryGCD(b)
+}
+
+// LCM returns the least common multiple of a and b, Ex: the LCM of 4 and 6
+// is 12. The result is never negative, and it is 0 when a or b is 0.
+func LCM(a, b *BigInt) *BigInt {
+	a = a.orZero()
+
+	// A nil operand is treated as zero
+	b = b.inChunksOf(a.chunkSize())
+
+	base := a.base()
+	lhs, rhs := normalizeMagnitude(a.magnitude, base), normalizeMagnitude(b.magnitude, base)
+
+	if isZeroMagnitude(lhs) || isZeroMagnitude(rhs) {
+		return newBigIntFromMagnitude([]uint64{0}, a.chunkSize())
+	}
+
+	// INFO: dividing before multiplying keeps the product at the size of the result
+	quotient, _ := quoRemMagnitudes(lhs, a.BinaryGCD(b).magnitude, base)
+
+	return newBigIntFromMagnitude(mulMagnitudes(quotient, rhs, base), a.chunkSize())
+}
+
+// ExtGCD returns the greatest common divisor g of a and b along with the
+// Bézout coefficients x and y such that a*x + b*y = g, using the extended
+// Euclidean algorithm, Ex: 240 and 46 return 2, -9 and 47. Like GCD, g is never
+// negative. Like `big.Int.GCD`, 0 and 0 return 0, 0 and 0.
+func ExtGCD(a, b *BigInt) (g, x, y *BigInt) {
+	a = a.orZero()
+
+	// A nil operand is treated as zero
+	b = b.inChunksOf(a.chunkSize())
+
+	base, chunkSize := a.base(), a.chunkSize()
+
+	oldR, r := a.signed(), b.signed()
+	oldS, s := signedMagnitude{[]uint64{1}, false}, signedMagnitude{[]uint64{0}, false}
+	oldT, t := signedMagnitude{[]uint64{0}, false}, signedMagnitude{[]uint64{1}, false}
+
+	if isZeroMagnitude(oldR.magnitude) && isZeroMagnitude(r.magnitude) {
+		oldS = signedMagnitude{[]uint64{0}, false}
+	}
+
+	// INFO: every step keeps a*s + b*t = r for both pairs, the one with
+	// the old values ends with the last non-zero remainder
+	for !isZeroMagnitude(r.magnitude) {
+		quotient, remainder := quoRemMagnitudes(oldR.magnitude, r.magnitude, base)
+		q := signedMagnitude{quotient, oldR.negative != r.negative}
+
+		oldR, r = r, signedMagnitude{remainder, oldR.negative && !isZeroMagnitude(remainder)}
+		oldS, s = s, subSigned(oldS, mulSigned(q, s, base), base)
+		oldT, t = t, subSigned(oldT, mulSigned(q, t, base), base)
+	}
+
+	// The GCD is never negative, so the whole identity is negated. The
+	// magnitude may still be the one of an operand
+	oldR.magnitude = slices.Clone(oldR.magnitude)
+
+	if oldR.negative {
+		oldR.negative, oldS.negative, oldT.negative = false, !oldS.negative, !oldT.negative
+	}
+
+	return newBigIntFromSigned(oldR, chunkSize), newBigIntFromSigned(oldS, chunkSize), newBigIntFromSigned(oldT, chunkSize)
+}
+
+// ModInverse returns the inverse of a modulo m, the x in [0, |m|) such that
+// a*x mod m is 1, Ex: the inverse of 3 modulo 11 is 4. It returns
+// ErrDivisionByZero when m is zero, and ErrNoInverse when a and m are not
+// coprime.
+func ModInverse(a, m *BigInt) (*BigInt, error) {
+	if m.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+
+	g, x, _ := ExtGCD(a, m)
+	if !g.IsOne() {
+		return nil, ErrNoInverse
+	}
+
+	return x.EuclideanMod(m)
+}
+
+// mulSigned returns lhs * rhs, both stored in chunks of the given base.
+func mulSigned(lhs, rhs signedMagnitude, base uint64) signedMagnitude {
+	return signedMagnitude{mulMagnitudes(lhs.magnitude, rhs.magnitude, base), lhs.negative != rhs.negative}
+}
+
+// trailingZeroBits returns the number of trailing zero bits of the magnitude,
+// up to chunkSize bits.
+//
+// INFO: the base 10^chunkSize is a multiple of 2^chunkSize, so the last chunk
+// has the same remainder modulo 2^chunkSize as the whole magnitude.
+func trailingZeroBits(magnitude []uint64, chunkSize int) int {
+	last := magnitude[len(magnitude)-1]
+	if last == 0 {
+		return chunkSize
+	}
+
+	return min(bits.TrailingZeros64(last), chunkSize)
+}
+
+// halveMagnitude divides the magnitude in place by 2^count, which must divide it.
+func halveMagnitude(magnitude []uint64, base uint64, count int) []uint64 {
+	divModUint64(magnitude, magnitude, base, 1<<count)
+
+	return trimLeadingZeroChunks(magnitude)
+}
+
+// removeFactorsOfTwo divides the non-zero magnitude in place by
+// 2 until it is odd.
+func removeFactorsOfTwo(magnitude []uint64, base uint64, chunkSize int) []uint64 {
+	for count := trailingZeroBits(magnitude, chunkSize); count > 0; count = trailingZeroBits(magnitude, chunkSize) {
+		magnitude = halveMagnitude(magnitude, base, count)
+	}
+
+	return magnitude
+}
+
+// primalityWitnesses are the Miller-Rabin bases that tell apart every prime
+// below 3.3 * 10^24 from a composite, which covers all the uint64 values.
+var primalityWitnesses = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// ProbablyPrime reports whether the BigInt is probably a prime using the
+// Miller-Rabin test, like `big.Int.ProbablyPrime`. The values below 2,
+// including the negative ones, are never primes.
+//
+// The values that fit in an uint64 are tested with a deterministic set of
+// witnesses, so the answer is exact and rounds is ignored. The larger ones
+// are tested with the base 2 and rounds pseudo-random bases, a composite
+// passes with a probability of at most 4^-rounds. The bases are chosen from
+// the value, so the same value always gets the same answer. It panics when
+// rounds is negative.
+func (b *BigInt) ProbablyPrime(rounds int) bool {
+	if rounds < 0 {
+		panic("bignumber: ProbablyPrime with a negative number of rounds")
+	}
+
+	b = b.inChunksOf(maxChunkSize)
+
+	if b.negative {
+		return false
+	}
+
+	if value, err := b.ToUint64(); err == nil {
+		return probablyPrimeUint64(value)
+	}
+
+	// INFO: the trial division by the witnesses rejects most of the composites
+	// before going through the modular exponentiations
+	for _, prime := range primalityWitnesses {
+		if divModUint64(nil, b.signed().magnitude, b.base(), prime) == 0 {
+			return false
+		}
+	}
+
+	return b.millerRabin(rounds)
+}
+
+// millerRabin runs the Miller-Rabin test on the odd BigInt above 2^64 with
+// the base 2 and rounds pseudo-random bases in [2, b-2].
+func (b *BigInt) millerRabin(rounds int) bool {
+	base, chunkSize := b.base(), b.chunkSize()
+	magnitude := normalizeMagnitude(b.magnitude, base)
+
+	// Write b-1 as odd * 2^shift
+	minusOne := trimLeadingZeroChunks(subMagnitudes(magnitude, []uint64{1}, base))
+	odd := slices.Clone(minusOne)
+
+	var shift int
+
+	for count := trailingZeroBits(odd, chunkSize); count > 0; count = trailingZeroBits(odd, chunkSize) {
+		odd = halveMagnitude(odd, base, count)
+		shift += count
+	}
+
+	exponent := newBigIntFromMagnitude(odd, chunkSize)
+
+	// INFO: the source is seeded from the value so the answer is reproducible
+	random := rand.New(rand.NewSource(int64(magnitude[len(magnitude)-1])))
+	span := trimLeadingZeroChunks(subMagnitudes(magnitude, []uint64{3}, base))
+
+	for round := 0; round <= rounds; round++ {
+		witness := []uint64{2}
+
+		if round > 0 {
+			// A random value one word longer than b keeps the bias of the reduction low
+			data := make([]byte, 8*(len(magnitude)+1))
+			random.Read(data)
+
+			_, witness = quoRemMagnitudes(NewBigIntFromBytes(data).magnitude, span, base)
+			witness = addMagnitudes(witness, []uint64{2}, base)
+		}
+
+		x := newBigIntFromMagnitude(trimLeadingZeroChunks(witness), chunkSize).ExpMod(exponent, b).magnitude
+
+		if isOneMagnitude(x) || cmpMagnitudes(x, minusOne) == 0 {
+			continue
+		}
+
+		witnessed := true
+
+		for range shift - 1 {
+			_, x = quoRemMagnitudes(mulMagnitudes(x, x, base), magnitude, base)
+
+			if cmpMagnitudes(x, minusOne) == 0 {
+				witnessed = false
+
+				break
+			}
+
+			if isOneMagnitude(x) {
+				break
+			}
+		}
+
+		if witnessed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// probablyPrimeUint64 reports whether the value is a prime using the
+// Miller-Rabin test with the deterministic witnesses, the answer is exact.
+func probablyPrimeUint64(value uint64) bool {
+	if value < 2 {
+		return false
+	}
+
+	for _, prime := range primalityWitnesses {
+		if value%prime == 0 {
+			return value == prime
+		}
+	}
+
+	// Write value-1 as odd * 2^shift
+	shift := bits.TrailingZeros64(value - 1)
+	odd := (value - 1) >> shift
+
+	for _, witness := range primalityWitnesses {
+		x := expModUint64(witness, odd, value)
+
+		if x == 1 || x == value-1 {
+			continue
+		}
+
+		witnessed := true
+
+		for range shift - 1 {
+			x = mulModUint64(x, x, value)
+
+			if x == value-1 {
+				witnessed = false
+
+				break
+			}
+		}
+
+		if witnessed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// expModUint64 returns base^exp mod m using square-and-multiply.
+func expModUint64(base, exp, m uint64) uint64 {
+	result := uint64(1)
+	base %= m
+
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = mulModUint64(result, base, m)
+		}
+
+		base = mulModUint64(base, base, m)
+	}
+
+	return result
+}
+
+// mulModUint64 returns lhs * rhs mod m without overflowing, lhs and rhs must be lower than m.
+func mulModUint64(lhs, rhs, m uint64) uint64 {
+	hi, lo := bits.Mul64(lhs, rhs)
+
+	return bits.Rem64(hi, lo, m)
+}
+
+// isOneMagnitude reports whether the normalized magnitude is one.
+func isOneMagnitude(magnitude []uint64) bool {
+	return len(magnitude) == 1 && magnitude[0] == 1
+}
@@ -0,0 +1,765 @@
+package bignumber
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestBigIntFactorize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+		err   error
+	}{
+		{
+			input: "0",
+			want:  nil,
+			err:   ErrFactorizingZero,
+		},
+		{
+			input: "1",
+			want:  []string{},
+			err:   nil,
+		},
+		{
+			input: "2",
+			want:  []string{"2"},
+			err:   nil,
+		},
+		{
+			input: "360",
+			want:  []string{"2", "2", "2", "3", "3", "5"},
+			err:   nil,
+		},
+		{
+			input: "1000000007",
+			want:  []string{"1000000007"},
+			err:   nil,
+		},
+		{
+			input: "18446744073709551616",
+			want: []string{
+				"2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2",
+				"2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2",
+				"2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2",
+				"2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2",
+			},
+			err: nil,
+		},
+		{
+			// INFO: 2^64 * 3^5 * 999983 * 1000003
+			input: "4482496053859471783487919894822912",
+			want: []string{
+				"2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2",
+				"2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2",
+				"2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2",
+				"2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2",
+				"3", "3", "3", "3", "3", "999983", "1000003",
+			},
+			err: nil,
+		},
+		{
+			input: "-12",
+			want:  []string{"2", "2", "3"},
+			err:   nil,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := MustNewBigInt(tc.input).Factorize()
+			if err != tc.err {
+				t.Fatalf("got %v, want %v", err, tc.err)
+			}
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+
+			for i := range got {
+				if got[i].String() != tc.want[i] {
+					t.Errorf("got %v, want %v", got[i].String(), tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBigIntCollatzSteps(t *testing.T) {
+	tests := []struct {
+		input string
+		want  uint64
+	}{
+		{
+			input: "0",
+			want:  0,
+		},
+		{
+			input: "1",
+			want:  0,
+		},
+		{
+			input: "2",
+			want:  1,
+		},
+		{
+			input: "27",
+			want:  111,
+		},
+		{
+			input: "97",
+			want:  118,
+		},
+		{
+			input: "1267650600228229401496703205376",
+			want:  100,
+		},
+		{
+			input: "123456789012345678901234567890",
+			want:  800,
+		},
+		{
+			input: "340282366920938463463374607431768211455",
+			want:  1661,
+		},
+		{
+			input: "-1",
+			want:  0,
+		},
+		{
+			input: "-7",
+			want:  0,
+		},
+		{
+			input: "-123456789012345678901234567890",
+			want:  0,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := MustNewBigInt(tc.input).CollatzSteps(); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	var value *BigInt
+
+	if got := value.CollatzSteps(); got != 0 {
+		t.Errorf("got %v, want %v", got, 0)
+	}
+}
+
+func TestBigIntGCD(t *testing.T) {
+	tests := []struct {
+		lhs  string
+		rhs  string
+		want string
+	}{
+		{
+			lhs:  "0",
+			rhs:  "0",
+			want: "0",
+		},
+		{
+			lhs:  "0",
+			rhs:  "7",
+			want: "7",
+		},
+		{
+			lhs:  "12",
+			rhs:  "0",
+			want: "12",
+		},
+		{
+			lhs:  "12",
+			rhs:  "18",
+			want: "6",
+		},
+		{
+			lhs:  "17",
+			rhs:  "5",
+			want: "1",
+		},
+		{
+			lhs:  "-12",
+			rhs:  "18",
+			want: "6",
+		},
+		{
+			lhs:  "-12",
+			rhs:  "-18",
+			want: "6",
+		},
+		{
+			lhs:  "1267650600228229401496703205376",
+			rhs:  "1000000000000000000000000000000",
+			want: "1073741824",
+		},
+		{
+			lhs:  "1000000000000000000000000000000000000",
+			rhs:  "2000000000000000000",
+			want: "2000000000000000000",
+		},
+		{
+			lhs:  "123456789012345678901234567890",
+			rhs:  "987654321098765432109876543210",
+			want: "9000000000900000000090",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			lhs, rhs := MustNewBigInt(tc.lhs), MustNewBigInt(tc.rhs)
+
+			if got := lhs.GCD(rhs); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+
+			if got := lhs.BinaryGCD(rhs); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+
+			if got := GCD(lhs, rhs); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestLCM(t *testing.T) {
+	tests := []struct {
+		lhs  *BigInt
+		rhs  *BigInt
+		want string
+	}{
+		{
+			lhs:  MustNewBigInt("0"),
+			rhs:  MustNewBigInt("0"),
+			want: "0",
+		},
+		{
+			lhs:  MustNewBigInt("0"),
+			rhs:  MustNewBigInt("7"),
+			want: "0",
+		},
+		{
+			lhs:  nil,
+			rhs:  MustNewBigInt("7"),
+			want: "0",
+		},
+		{
+			lhs:  MustNewBigInt("4"),
+			rhs:  MustNewBigInt("6"),
+			want: "12",
+		},
+		{
+			lhs:  MustNewBigInt("-4"),
+			rhs:  MustNewBigInt("6"),
+			want: "12",
+		},
+		{
+			lhs:  MustNewBigInt("-4"),
+			rhs:  MustNewBigInt("-6"),
+			want: "12",
+		},
+		{
+			lhs:  MustNewBigInt("17"),
+			rhs:  MustNewBigInt("5"),
+			want: "85",
+		},
+		{
+			lhs:  MustNewBigInt("123456789012345678901234567890", WithChunkSize(3)),
+			rhs:  MustNewBigInt("987654321098765432109876543210"),
+			want: "13548070124980948012498094801236261410",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := LCM(tc.lhs, tc.rhs); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+
+			if got := LCM(tc.rhs, tc.lhs); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestLCMAgainstBig(t *testing.T) {
+	random := rand.New(rand.NewSource(260))
+
+	for idx := range 100 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			common := MustNewBigInt(randomNumber(random, 1+random.Intn(30)))
+			lhs := MustNewBigInt(randomNumber(random, 1+random.Intn(60))).Mul(common)
+			rhs := MustNewBigInt(randomNumber(random, 1+random.Intn(60))).Mul(common)
+
+			lhsInt, _ := new(big.Int).SetString(lhs.String(), 10)
+			rhsInt, _ := new(big.Int).SetString(rhs.String(), 10)
+
+			want := new(big.Int).Mul(lhsInt, rhsInt)
+			if want.Sign() != 0 {
+				want.Quo(want, new(big.Int).GCD(nil, nil, lhsInt, rhsInt))
+			}
+
+			if got := LCM(lhs, rhs); got.String() != want.String() {
+				t.Errorf("LCM(%v, %v): got %v, want %v", lhs, rhs, got, want)
+			}
+		})
+	}
+}
+
+func TestBigIntBinaryGCDMatchesGCD(t *testing.T) {
+	random := rand.New(rand.NewSource(175))
+
+	for idx := range 200 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			// INFO: a random common factor makes the GCD more than 1
+			common := MustNewBigInt(randomNumber(random, 1+random.Intn(40)))
+			lhs := MustNewBigInt(randomNumber(random, 1+random.Intn(80))).Mul(common)
+			rhs := MustNewBigInt(randomNumber(random, 1+random.Intn(80))).Mul(common)
+
+			lhsInt, _ := new(big.Int).SetString(lhs.String(), 10)
+			rhsInt, _ := new(big.Int).SetString(rhs.String(), 10)
+			want := new(big.Int).GCD(nil, nil, lhsInt, rhsInt).String()
+
+			if got := lhs.GCD(rhs).String(); got != want {
+				t.Errorf("GCD(%v, %v): got %v, want %v", lhs, rhs, got, want)
+			}
+
+			if got := lhs.BinaryGCD(rhs).String(); got != want {
+				t.Errorf("BinaryGCD(%v, %v): got %v, want %v", lhs, rhs, got, want)
+			}
+		})
+	}
+}
+
+func TestExtGCD(t *testing.T) {
+	tests := []struct {
+		lhs string
+		rhs string
+		g   string
+		x   string
+		y   string
+	}{
+		{
+			lhs: "0",
+			rhs: "0",
+			g:   "0",
+			x:   "0",
+			y:   "0",
+		},
+		{
+			lhs: "0",
+			rhs: "-7",
+			g:   "7",
+			x:   "0",
+			y:   "-1",
+		},
+		{
+			lhs: "-12",
+			rhs: "0",
+			g:   "12",
+			x:   "-1",
+			y:   "0",
+		},
+		{
+			lhs: "240",
+			rhs: "46",
+			g:   "2",
+			x:   "-9",
+			y:   "47",
+		},
+		{
+			lhs: "-240",
+			rhs: "46",
+			g:   "2",
+			x:   "9",
+			y:   "47",
+		},
+		{
+			lhs: "17",
+			rhs: "5",
+			g:   "1",
+			x:   "-2",
+			y:   "7",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			g, x, y := ExtGCD(MustNewBigInt(tc.lhs), MustNewBigInt(tc.rhs))
+
+			if g.String() != tc.g || x.String() != tc.x || y.String() != tc.y {
+				t.Errorf("got %v, %v and %v, want %v, %v and %v", g, x, y, tc.g, tc.x, tc.y)
+			}
+		})
+	}
+}
+
+func TestExtGCDAgainstBig(t *testing.T) {
+	random := rand.New(rand.NewSource(261))
+
+	for idx := range 200 {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		lhs := randomNumber(random, 1+random.Intn(60))
+		rhs := randomNumber(random, 1+random.Intn(60))
+		chunkSize := 1 + random.Intn(maxChunkSize)
+
+		if random.Intn(2) == 0 {
+			lhs = "-" + lhs
+		}
+
+		if random.Intn(2) == 0 {
+			rhs = "-" + rhs
+		}
+
+		t.Run(testname, func(t *testing.T) {
+			g, x, y := ExtGCD(MustNewBigInt(lhs, WithChunkSize(chunkSize)), MustNewBigInt(rhs))
+
+			lhsInt, _ := new(big.Int).SetString(lhs, 10)
+			rhsInt, _ := new(big.Int).SetString(rhs, 10)
+			want := new(big.Int).GCD(nil, nil, new(big.Int).Abs(lhsInt), new(big.Int).Abs(rhsInt))
+
+			if g.String() != want.String() {
+				t.Fatalf("got %v, want %v", g, want)
+			}
+
+			// The coefficients must satisfy the Bézout identity
+			xInt, _ := new(big.Int).SetString(x.String(), 10)
+			yInt, _ := new(big.Int).SetString(y.String(), 10)
+			identity := new(big.Int).Add(new(big.Int).Mul(lhsInt, xInt), new(big.Int).Mul(rhsInt, yInt))
+
+			if identity.Cmp(want) != 0 {
+				t.Errorf("%v*%v + %v*%v: got %v, want %v", lhs, x, rhs, y, identity, want)
+			}
+		})
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	tests := []struct {
+		value   string
+		modulus string
+		want    string
+		err     error
+	}{
+		{
+			value:   "3",
+			modulus: "11",
+			want:    "4",
+			err:     nil,
+		},
+		{
+			value:   "-3",
+			modulus: "11",
+			want:    "7",
+			err:     nil,
+		},
+		{
+			value:   "3",
+			modulus: "-11",
+			want:    "4",
+			err:     nil,
+		},
+		{
+			value:   "14",
+			modulus: "11",
+			want:    "4",
+			err:     nil,
+		},
+		{
+			value:   "5",
+			modulus: "1",
+			want:    "0",
+			err:     nil,
+		},
+		{
+			value:   "123456789012345678901234567890",
+			modulus: "170141183460469231731687303715884105727",
+			want:    "",
+			err:     nil,
+		},
+		{
+			value:   "6",
+			modulus: "9",
+			want:    "",
+			err:     ErrNoInverse,
+		},
+		{
+			value:   "0",
+			modulus: "7",
+			want:    "",
+			err:     ErrNoInverse,
+		},
+		{
+			value:   "3",
+			modulus: "0",
+			want:    "",
+			err:     ErrDivisionByZero,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := ModInverse(MustNewBigInt(tc.value), MustNewBigInt(tc.modulus))
+			if err != tc.err {
+				t.Fatalf("got %v, want %v", err, tc.err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			value, _ := new(big.Int).SetString(tc.value, 10)
+			modulus, _ := new(big.Int).SetString(tc.modulus, 10)
+			want := new(big.Int).ModInverse(value, new(big.Int).Abs(modulus))
+
+			if tc.want != "" && want.String() != tc.want {
+				t.Fatalf("got %v from big, want %v", want, tc.want)
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBigIntGCDKeepsOperands(t *testing.T) {
+	lhs, rhs := MustNewBigInt("123456789012345678901234567890"), MustNewBigInt("0")
+
+	lhsExt, _, _ := ExtGCD(lhs, rhs)
+	rhsExt, _, _ := ExtGCD(rhs, lhs)
+
+	for _, gcd := range []*BigInt{lhs.GCD(rhs), lhs.BinaryGCD(rhs), rhs.GCD(lhs), rhs.BinaryGCD(lhs), lhsExt, rhsExt} {
+		_ = gcd.SubInPlace(gcd)
+	}
+
+	if lhs.String() != "123456789012345678901234567890" || rhs.String() != "0" {
+		t.Errorf("got %v and %v, want the operands unchanged", lhs, rhs)
+	}
+}
+
+// gcdOperands returns two random values of size digits with a common factor.
+func gcdOperands(size int) (*BigInt, *BigInt) {
+	random := rand.New(rand.NewSource(int64(size)))
+
+	common := MustNewBigInt(randomNumber(random, size/4))
+	lhs := MustNewBigInt(randomNumber(random, size-size/4)).Mul(common)
+	rhs := MustNewBigInt(randomNumber(random, size-size/4)).Mul(common)
+
+	return lhs, rhs
+}
+
+func BenchmarkBigIntGCD(b *testing.B) {
+	for _, size := range []int{40, 200, 1000, 5000} {
+		lhs, rhs := gcdOperands(size)
+
+		b.Run(fmt.Sprintf("digits=%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = lhs.GCD(rhs)
+			}
+		})
+	}
+}
+
+func BenchmarkBigIntBinaryGCD(b *testing.B) {
+	for _, size := range []int{40, 200, 1000, 5000} {
+		lhs, rhs := gcdOperands(size)
+
+		b.Run(fmt.Sprintf("digits=%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = lhs.BinaryGCD(rhs)
+			}
+		})
+	}
+}
+
+func TestBigIntProbablyPrime(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{
+			input: "0",
+			want:  false,
+		},
+		{
+			input: "1",
+			want:  false,
+		},
+		{
+			input: "2",
+			want:  true,
+		},
+		{
+			input: "-7",
+			want:  false,
+		},
+		{
+			// INFO: the smallest Carmichael number, a Fermat pseudoprime to every coprime base
+			input: "561",
+			want:  false,
+		},
+		{
+			// INFO: the smallest strong pseudoprime to the base 2
+			input: "2047",
+			want:  false,
+		},
+		{
+			// INFO: the smallest strong pseudoprime to the bases 2, 3, 5 and 7
+			input: "3215031751",
+			want:  false,
+		},
+		{
+			input: "1000000007",
+			want:  true,
+		},
+		{
+			// INFO: the largest prime below 2^64
+			input: "18446744073709551557",
+			want:  true,
+		},
+		{
+			input: "18446744073709551615",
+			want:  false,
+		},
+		{
+			// INFO: the smallest prime above 2^64
+			input: "18446744073709551629",
+			want:  true,
+		},
+		{
+			// INFO: the Mersenne prime 2^127 - 1
+			input: "170141183460469231731687303715884105727",
+			want:  true,
+		},
+		{
+			// INFO: 2^128 + 1 = 59649589127497217 * 5704689200685129054721
+			input: "340282366920938463463374607431768211457",
+			want:  false,
+		},
+		{
+			// INFO: the product of the primes 2^61 - 1 and 2^89 - 1
+			input: "1427247692705959880439315947500961989719490561",
+			want:  false,
+		},
+		{
+			// INFO: the smallest strong pseudoprime to the bases 2 to 23
+			input: "3825123056546413051",
+			want:  false,
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			value, _ := new(big.Int).SetString(tc.input, 10)
+			if want := value.ProbablyPrime(20); want != tc.want {
+				t.Fatalf("got %v from big, want %v", want, tc.want)
+			}
+
+			for chunkSize := 1; chunkSize <= maxChunkSize; chunkSize += 6 {
+				if got := MustNewBigInt(tc.input, WithChunkSize(chunkSize)).ProbablyPrime(10); got != tc.want {
+					t.Errorf("chunk size %d: got %v, want %v", chunkSize, got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestBigIntProbablyPrimeRandomBases(t *testing.T) {
+	// INFO: the smallest strong pseudoprime to the bases 2 to 37, it is above
+	// 2^64 so only the random bases can tell it is a composite
+	value := MustNewBigInt("3317044064679887385961981")
+
+	if !value.ProbablyPrime(0) {
+		t.Errorf("got %v with the base 2 only, want %v", false, true)
+	}
+
+	if value.ProbablyPrime(20) {
+		t.Errorf("got %v with the random bases, want %v", true, false)
+	}
+}
+
+func TestBigIntProbablyPrimeAgainstBig(t *testing.T) {
+	for n := int64(-10); n < 5000; n++ {
+		if got, want := MustNewBigInt(fmt.Sprint(n)).ProbablyPrime(0), big.NewInt(n).ProbablyPrime(0); got != want {
+			t.Errorf("%v: got %v, want %v", n, got, want)
+		}
+	}
+
+	random := rand.New(rand.NewSource(267))
+
+	for idx := range 300 {
+		input := randomNumber(random, 15+random.Intn(60))
+		value, _ := new(big.Int).SetString(input, 10)
+
+		// INFO: the random values are rarely primes, the next prime after them covers the other answer
+		if idx%3 == 0 {
+			for !value.ProbablyPrime(20) {
+				value.Add(value, big.NewInt(1))
+			}
+
+			input = value.String()
+		}
+
+		if got, want := MustNewBigInt(input).ProbablyPrime(10), value.ProbablyPrime(20); got != want {
+			t.Errorf("%v: got %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestBigIntProbablyPrimePanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("got no panic, want panic")
+		}
+	}()
+
+	MustNewBigInt("7").ProbablyPrime(-1)
+}
+
+func BenchmarkBigIntProbablyPrime(b *testing.B) {
+	for _, input := range []string{
+		"18446744073709551557",
+		"170141183460469231731687303715884105727",
+		// INFO: the Mersenne prime 2^521 - 1
+		new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 521), big.NewInt(1)).String(),
+	} {
+		value := MustNewBigInt(input)
+
+		b.Run(fmt.Sprintf("digits=%d", len(input)), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				value.ProbablyPrime(20)
+			}
+		})
+	}
+}
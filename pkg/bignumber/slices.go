@@ -0,0 +1,80 @@
+package bignumber
+
+import "slices"
+
+// BigInts is a slice of BigInts that implements sort.Interface
+// in ascending order.
+type BigInts []*BigInt
+
+// Len returns the number of values in the slice.
+func (s BigInts) Len() int {
+	return len(s)
+}
+
+// Less reports whether the value at i is lower than the value at j.
+func (s BigInts) Less(i, j int) bool {
+	return s[i].Cmp(s[j]) < 0
+}
+
+// Swap swaps the values at i and j.
+func (s BigInts) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// Sort sorts the values in ascending order, equal values keep their relative order.
+func (s BigInts) Sort() {
+	slices.SortStableFunc(s, func(lhs, rhs *BigInt) int {
+		return lhs.Cmp(rhs)
+	})
+}
+
+// SortDescending sorts the values in descending order,
+// equal values keep their relative order.
+func (s BigInts) SortDescending() {
+	slices.SortStableFunc(s, func(lhs, rhs *BigInt) int {
+		return rhs.Cmp(lhs)
+	})
+}
+
+// Unique returns the distinct values of nums in the order they are first seen,
+// the values are compared by their Key so "007" and "7" are the same value.
+func Unique(nums []*BigInt) []*BigInt {
+	seen := make(map[string]struct{}, len(nums))
+	result := make([]*BigInt, 0, len(nums))
+
+	for _, num := range nums {
+		key := num.Key()
+
+		if _, found := seen[key]; found {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		result = append(result, num)
+	}
+
+	return result
+}
+
+// Sum returns the sum of nums, the sum of an empty slice is 0.
+func Sum(nums []*BigInt) *BigInt {
+	var accumulator Accumulator
+
+	for _, num := range nums {
+		accumulator.Add(num)
+	}
+
+	return accumulator.Sum()
+}
+
+// Average returns the arithmetic mean of nums truncated toward zero like Div,
+// Ex: [1, 2] returns 1. It returns ErrEmptySlice when nums is empty.
+func Average(nums []*BigInt) (*BigInt, error) {
+	if len(nums) == 0 {
+		return nil, ErrEmptySlice
+	}
+
+	count := magnitudeFromUint64(uint64(len(nums)), powersOfTen[maxChunkSize])
+
+	return Sum(nums).Div(newBigIntFromMagnitude(count, maxChunkSize))
+}
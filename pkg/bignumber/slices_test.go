@@ -0,0 +1,190 @@
+package bignumber
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestUnique(t *testing.T) {
+	tests := []struct {
+		nums []*BigInt
+		want []string
+	}{
+		{
+			nums: nil,
+			want: []string{},
+		},
+		{
+			nums: []*BigInt{MustNewBigInt("007"), MustNewBigInt("7"), MustNewBigInt("70"), MustNewBigInt("0007")},
+			want: []string{"7", "70"},
+		},
+		{
+			nums: []*BigInt{MustNewBigInt("3"), MustNewBigInt("1"), MustNewBigInt("3"), MustNewBigInt("2"), MustNewBigInt("1")},
+			want: []string{"3", "1", "2"},
+		},
+		{
+			nums: []*BigInt{MustNewBigInt("1234"), {magnitude: []uint64{1, 234}, chukSize: 3}, {magnitude: []uint64{0, 1234}}},
+			want: []string{"1234"},
+		},
+		{
+			nums: []*BigInt{nil, MustNewBigInt("0"), {}, MustNewBigInt("000000000000000000000")},
+			want: []string{"0"},
+		},
+		{
+			nums: []*BigInt{MustNewBigInt("999999999").Add(MustNewBigInt("1")), MustNewBigInt("1000000000")},
+			want: []string{"1000000000"},
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got := Unique(tc.nums)
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %v values, want %v", len(got), len(tc.want))
+			}
+
+			for i, num := range got {
+				if num.String() != tc.want[i] {
+					t.Errorf("got %v, want %v", num.String(), tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		nums []*BigInt
+		want string
+	}{
+		{
+			nums: nil,
+			want: "0",
+		},
+		{
+			nums: []*BigInt{MustNewBigInt("1"), nil, MustNewBigInt("2")},
+			want: "3",
+		},
+		{
+			nums: []*BigInt{MustNewBigInt("999999999999999999"), MustNewBigInt("1"), MustNewBigInt("123456789012345678901234567890")},
+			want: "123456789013345678901234567890",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			if got := Sum(tc.nums); got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestAverage(t *testing.T) {
+	tests := []struct {
+		nums []*BigInt
+		want string
+	}{
+		{
+			nums: []*BigInt{MustNewBigInt("2"), MustNewBigInt("4"), MustNewBigInt("6")},
+			want: "4",
+		},
+		{
+			nums: []*BigInt{MustNewBigInt("1"), MustNewBigInt("2")},
+			want: "1",
+		},
+		{
+			nums: []*BigInt{MustNewBigInt("7")},
+			want: "7",
+		},
+		{
+			nums: []*BigInt{MustNewBigInt("123456789012345678901234567890"), MustNewBigInt("987654321098765432109876543210"), MustNewBigInt("1")},
+			want: "370370370037037037003703703700",
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			got, err := Average(tc.nums)
+			if err != nil {
+				t.Fatalf("got %v, want nil", err)
+			}
+
+			if got.String() != tc.want {
+				t.Errorf("got %v, want %v", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestAverageEmpty(t *testing.T) {
+	if _, err := Average(nil); err != ErrEmptySlice {
+		t.Errorf("got %v, want %v", err, ErrEmptySlice)
+	}
+}
+
+func TestBigIntsSort(t *testing.T) {
+	values := BigInts{
+		MustNewBigInt("42"),
+		MustNewBigInt("123456789012345678901234567890"),
+		MustNewBigInt("0"),
+		MustNewBigInt("007"),
+		MustNewBigInt("999999999"),
+		MustNewBigInt("7"),
+		MustNewBigInt("1000000000"),
+	}
+
+	ascending := slices.Clone(values)
+	ascending.Sort()
+
+	descending := slices.Clone(values)
+	descending.SortDescending()
+
+	tests := []struct {
+		got  BigInts
+		want []*BigInt
+	}{
+		{
+			got:  ascending,
+			want: []*BigInt{values[2], values[3], values[5], values[0], values[4], values[6], values[1]},
+		},
+		{
+			got:  descending,
+			want: []*BigInt{values[1], values[6], values[4], values[0], values[3], values[5], values[2]},
+		},
+	}
+
+	for idx, tc := range tests {
+		testname := fmt.Sprintf("test#%d", idx)
+
+		t.Run(testname, func(t *testing.T) {
+			// INFO: the pointers are compared so "007" and "7" must keep their order
+			for i := range tc.want {
+				if tc.got[i] != tc.want[i] {
+					t.Errorf("got %v at %d, want %v", tc.got[i], i, tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBigIntsSortInterface(t *testing.T) {
+	values := BigInts{MustNewBigInt("3"), MustNewBigInt("1"), MustNewBigInt("2")}
+
+	sort.Sort(sort.Reverse(values))
+
+	for i, want := range []string{"3", "2", "1"} {
+		if got := values[i].String(); got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+}